@@ -29,6 +29,12 @@ var AccountThree = types.AccountThree
 // AccountFour represents the 0x4 address
 var AccountFour = types.AccountFour
 
+// AddressFromPublicKey derives the AccountAddress of an account created with the public key, which is the same as its
+// authentication key.  This doesn't account for key rotation, where the address stays the same but the key changes.
+func AddressFromPublicKey(publicKey crypto.PublicKey) AccountAddress {
+	return types.AddressFromPublicKey(publicKey)
+}
+
 // NewAccountFromSigner creates an account from a Signer, which is most commonly a private key
 func NewAccountFromSigner(signer crypto.Signer, authKey ...crypto.AuthenticationKey) (*Account, error) {
 	return types.NewAccountFromSigner(signer, authKey...)
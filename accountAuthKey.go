@@ -0,0 +1,152 @@
+package aptos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
+	"github.com/aptos-labs/aptos-go-sdk/internal/util"
+)
+
+// AccountAuthKey retrieves the current [crypto.AuthenticationKey] of the account, from the `0x1::account::Account`
+// resource.  This may differ from the account's address if the key has been rotated.
+//
+// Optionally, a ledgerVersion can be given to get the authentication key at a specific ledger version
+func (rc *NodeClient) AccountAuthKey(address AccountAddress, ledgerVersion ...uint64) (*crypto.AuthenticationKey, error) {
+	info, err := rc.Account(address, ledgerVersion...)
+	if err != nil {
+		return nil, err
+	}
+	authKeyBytes, err := info.AuthenticationKey()
+	if err != nil {
+		return nil, fmt.Errorf("bad authentication key %s: %w", info.AuthenticationKeyHex, err)
+	}
+	authKey := &crypto.AuthenticationKey{}
+	if err = authKey.FromBytes(authKeyBytes); err != nil {
+		return nil, err
+	}
+	return authKey, nil
+}
+
+// AccountScheme retrieves the [crypto.DeriveScheme] the account currently signs with e.g. [crypto.Ed25519Scheme] or
+// [crypto.SingleKeyScheme].
+//
+// The authentication key is a hash, so the scheme can't be read from the `0x1::account::Account` resource.  Instead,
+// it is taken from the signature of the last transaction sent by the account, and checked by deriving the
+// authentication key from that signature's public key.  An error is returned if the account hasn't sent any
+// transactions, or if the derived key doesn't match the on-chain key e.g. the key was rotated since, as the scheme
+// can't be determined in either case.
+func (rc *NodeClient) AccountScheme(address AccountAddress) (crypto.DeriveScheme, error) {
+	info, err := rc.Account(address)
+	if err != nil {
+		return 0, err
+	}
+	sequenceNumber, err := info.SequenceNumber()
+	if err != nil {
+		return 0, fmt.Errorf("bad sequence number %s: %w", info.SequenceNumberStr, err)
+	}
+	if sequenceNumber == 0 {
+		return 0, fmt.Errorf("account %s has not sent any transactions, its scheme can't be determined", address.String())
+	}
+	authKey, err := info.AuthenticationKey()
+	if err != nil {
+		return 0, fmt.Errorf("bad authentication key %s: %w", info.AuthenticationKeyHex, err)
+	}
+
+	start := sequenceNumber - 1
+	limit := uint64(1)
+	txns, err := rc.AccountTransactions(address, &start, &limit)
+	if err != nil {
+		return 0, err
+	}
+	if len(txns) == 0 {
+		return 0, fmt.Errorf("last transaction %d of account %s not found", start, address.String())
+	}
+	userTxn, err := txns[0].UserTransaction()
+	if err != nil {
+		return 0, err
+	}
+	publicKey, err := senderPublicKey(userTxn.Signature)
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(publicKey.AuthKey()[:], authKey) {
+		return 0, fmt.Errorf("authentication key of account %s doesn't match the signer of its last transaction %s, it may have been rotated, its scheme can't be determined", address.String(), userTxn.Hash)
+	}
+	return publicKey.Scheme(), nil
+}
+
+// senderPublicKey returns the [crypto.PublicKey] of the sender's signature on a transaction
+func senderPublicKey(signature *api.Signature) (crypto.PublicKey, error) {
+	if signature == nil {
+		return nil, errors.New("transaction has no signature")
+	}
+	switch inner := signature.Inner.(type) {
+	case *api.Ed25519Signature:
+		return inner.PubKey, nil
+	case *api.MultiEd25519Signature:
+		return &crypto.MultiEd25519PublicKey{
+			PubKeys:            inner.PublicKeys,
+			SignaturesRequired: inner.Threshold,
+		}, nil
+	case *api.SingleSenderSignature:
+		// The API doesn't label single sender signatures, so tell them apart by their fields
+		if rawKeys, ok := (*inner)["public_keys"]; ok {
+			keys, ok := rawKeys.([]any)
+			if !ok {
+				return nil, fmt.Errorf("bad public_keys %v", rawKeys)
+			}
+			signaturesRequired, ok := (*inner)["signatures_required"].(float64)
+			if !ok || signaturesRequired < 1 || signaturesRequired > 255 {
+				return nil, fmt.Errorf("bad signatures_required %v", (*inner)["signatures_required"])
+			}
+			multiKey := &crypto.MultiKey{
+				PubKeys:            make([]*crypto.AnyPublicKey, len(keys)),
+				SignaturesRequired: uint8(signaturesRequired),
+			}
+			for i, key := range keys {
+				pubKey, err := anyPublicKeyFromJSON(key)
+				if err != nil {
+					return nil, err
+				}
+				multiKey.PubKeys[i] = pubKey
+			}
+			return multiKey, nil
+		}
+		return anyPublicKeyFromJSON((*inner)["public_key"])
+	case *api.MultiAgentSignature:
+		return senderPublicKey(inner.Sender)
+	case *api.FeePayerSignature:
+		return senderPublicKey(inner.Sender)
+	default:
+		return nil, fmt.Errorf("unknown signature type %s", signature.Type)
+	}
+}
+
+// anyPublicKeyFromJSON parses a single sender public key from the API e.g. `{"type":"ed25519","value":"0x..."}`
+func anyPublicKeyFromJSON(raw any) (*crypto.AnyPublicKey, error) {
+	key, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("bad public key %v", raw)
+	}
+	var variant crypto.AnyPublicKeyVariant
+	switch key["type"] {
+	case "ed25519":
+		variant = crypto.AnyPublicKeyVariantEd25519
+	case "secp256k1_ecdsa":
+		variant = crypto.AnyPublicKeyVariantSecp256k1
+	default:
+		return nil, fmt.Errorf("unsupported public key type %v", key["type"])
+	}
+	value, ok := key["value"].(string)
+	if !ok {
+		return nil, fmt.Errorf("bad public key value %v", key["value"])
+	}
+	keyBytes, err := util.ParseHex(value)
+	if err != nil {
+		return nil, fmt.Errorf("bad public key value %s: %w", value, err)
+	}
+	return crypto.NewAnyPublicKey(variant, keyBytes)
+}
@@ -0,0 +1,90 @@
+package aptos
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountAuthKeyAndScheme(t *testing.T) {
+	const zeroSignature = "0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	const transferPayload = `{"type":"entry_function_payload","function":"0x1::aptos_account::transfer","type_arguments":[],"arguments":[]}`
+
+	ed25519Key, err := crypto.GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	ed25519PubKey := ed25519Key.PubKey().(*crypto.Ed25519PublicKey)
+	secp256k1Key, err := crypto.GenerateSecp256k1Key()
+	assert.NoError(t, err)
+	secp256k1PubKey := secp256k1Key.VerifyingKey().(*crypto.Secp256k1PublicKey)
+	ed25519Signature := fmt.Sprintf(`{"type":"ed25519_signature","public_key":"%s","signature":"%s"}`, ed25519PubKey.ToHex(), zeroSignature)
+
+	sequenceNumber := "1"
+	authKey := ed25519PubKey.AuthKey()
+	signature := ed25519Signature
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/0x2":
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"sequence_number":"%s","authentication_key":"%s"}`, sequenceNumber, authKey.ToHex())))
+		case "/accounts/0x2/transactions":
+			assert.Equal(t, "0", r.URL.Query().Get("start"))
+			_, _ = w.Write([]byte(fmt.Sprintf(`[{"type":"user_transaction","version":"10","hash":"0x1234","success":true,"vm_status":"Executed successfully","sender":"0x2","sequence_number":"0","changes":[],"events":[],"payload":%s,"signature":%s}]`, transferPayload, signature)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+
+	// The authentication key is read as is, and can differ from the address after rotation
+	onChainAuthKey, err := client.AccountAuthKey(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, authKey, onChainAuthKey)
+
+	scheme, err := client.AccountScheme(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.Ed25519Scheme, scheme)
+
+	singleKey, err := crypto.ToAnyPublicKey(secp256k1PubKey)
+	assert.NoError(t, err)
+	authKey = singleKey.AuthKey()
+	signature = fmt.Sprintf(`{"type":"single_sender","public_key":{"type":"secp256k1_ecdsa","value":"%s"},"signature":{"type":"secp256k1_ecdsa","value":"0x00"}}`, secp256k1PubKey.ToHex())
+	scheme, err = client.AccountScheme(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.SingleKeyScheme, scheme)
+
+	ed25519AnyKey, err := crypto.ToAnyPublicKey(ed25519PubKey)
+	assert.NoError(t, err)
+	multiKey := &crypto.MultiKey{PubKeys: []*crypto.AnyPublicKey{ed25519AnyKey, singleKey}, SignaturesRequired: 1}
+	authKey = multiKey.AuthKey()
+	signature = fmt.Sprintf(`{"type":"single_sender","public_keys":[{"type":"ed25519","value":"%s"},{"type":"secp256k1_ecdsa","value":"%s"}],"signatures":[],"signatures_required":1}`, ed25519PubKey.ToHex(), secp256k1PubKey.ToHex())
+	scheme, err = client.AccountScheme(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.MultiKeyScheme, scheme)
+
+	// Sponsored transactions use the sender's signature
+	multiEd25519Key := &crypto.MultiEd25519PublicKey{PubKeys: []*crypto.Ed25519PublicKey{ed25519PubKey}, SignaturesRequired: 1}
+	authKey = multiEd25519Key.AuthKey()
+	signature = fmt.Sprintf(`{"type":"fee_payer_signature","sender":{"type":"multi_ed25519_signature","public_keys":["%s"],"signatures":[],"threshold":1,"bitmap":"0x00000000"},"fee_payer_address":"0x3","fee_payer_signer":%s,"secondary_signer_addresses":[],"secondary_signers":[]}`, ed25519PubKey.ToHex(), ed25519Signature)
+	scheme, err = client.AccountScheme(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.MultiEd25519Scheme, scheme)
+
+	// The scheme is unknown after a rotation, e.g. by a delegate with the rotation capability, when the last
+	// transaction was signed with the old key
+	authKey = singleKey.AuthKey()
+	signature = ed25519Signature
+	_, err = client.AccountScheme(AccountTwo)
+	assert.ErrorContains(t, err, "rotated")
+
+	// Or before any transactions
+	authKey = ed25519PubKey.AuthKey()
+	sequenceNumber = "0"
+	_, err = client.AccountScheme(AccountTwo)
+	assert.Error(t, err)
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/hex"
 	"strconv"
 	"strings"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/aptos-labs/aptos-go-sdk/internal/util"
 )
 
 // AccountInfo is returned from calls to #Account()
@@ -25,3 +28,17 @@ func (ai AccountInfo) AuthenticationKey() ([]byte, error) {
 func (ai AccountInfo) SequenceNumber() (uint64, error) {
 	return strconv.ParseUint(ai.SequenceNumberStr, 10, 64)
 }
+
+// accountInfoFromBCS decodes the BCS `0x1::account::Account` resource returned by the account endpoint, which starts
+// with the authentication key and sequence number.  The remaining fields aren't part of [AccountInfo], so are ignored.
+func accountInfoFromBCS(data []byte) (info AccountInfo, err error) {
+	des := bcs.NewDeserializer(data)
+	authKey := des.ReadBytes()
+	sequenceNumber := des.U64()
+	if err = des.Error(); err != nil {
+		return info, err
+	}
+	info.AuthenticationKeyHex = util.BytesToHex(authKey)
+	info.SequenceNumberStr = strconv.FormatUint(sequenceNumber, 10)
+	return info, nil
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/aptos-labs/aptos-go-sdk/internal/types"
+)
+
+// AbortInfo describes a Move abort from a failed transaction, parsed from its VmStatus
+//
+// The VmStatus is one of the following forms:
+//
+//	Move abort in 0x1::coin: EINSUFFICIENT_BALANCE(0x10006): Not enough coins to complete transaction
+//	Move abort in 0x1::coin: 0x10006
+//	Move abort: code 0x10006
+type AbortInfo struct {
+	Address     *types.AccountAddress // Address of the module that aborted, nil if the abort was in a script
+	Module      string                // Module is the name of the module that aborted, empty if the abort was in a script
+	Code        uint64                // Code is the abort code
+	ReasonName  string                // ReasonName is the name of the error constant e.g. EINSUFFICIENT_BALANCE, empty if unknown
+	Description string                // Description is the doc comment of the error constant, empty if unknown
+}
+
+var (
+	moduleAbortWithInfoRegex = regexp.MustCompile(`^Move abort in (0x[0-9a-fA-F]+)::(\w+): (\w+)\((0x[0-9a-fA-F]+)\): ?(.*)$`)
+	moduleAbortRegex         = regexp.MustCompile(`^Move abort in (0x[0-9a-fA-F]+)::(\w+): (0x[0-9a-fA-F]+)$`)
+	scriptAbortRegex         = regexp.MustCompile(`^Move abort: code (0x[0-9a-fA-F]+)$`)
+)
+
+// ParseAbortInfo parses a VmStatus string into an [AbortInfo], returning false if it wasn't a Move abort
+func ParseAbortInfo(vmStatus string) (*AbortInfo, bool) {
+	if matches := moduleAbortWithInfoRegex.FindStringSubmatch(vmStatus); matches != nil {
+		info, ok := parseModuleAbort(matches[1], matches[2], matches[4])
+		if !ok {
+			return nil, false
+		}
+		info.ReasonName = matches[3]
+		info.Description = matches[5]
+		return info, true
+	}
+	if matches := moduleAbortRegex.FindStringSubmatch(vmStatus); matches != nil {
+		return parseModuleAbort(matches[1], matches[2], matches[3])
+	}
+	if matches := scriptAbortRegex.FindStringSubmatch(vmStatus); matches != nil {
+		code, err := strconv.ParseUint(matches[1], 0, 64)
+		if err != nil {
+			return nil, false
+		}
+		return &AbortInfo{Code: code}, true
+	}
+	return nil, false
+}
+
+func parseModuleAbort(addressStr string, module string, codeStr string) (*AbortInfo, bool) {
+	address := &types.AccountAddress{}
+	err := address.ParseStringRelaxed(addressStr)
+	if err != nil {
+		return nil, false
+	}
+	code, err := strconv.ParseUint(codeStr, 0, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &AbortInfo{
+		Address: address,
+		Module:  module,
+		Code:    code,
+	}, true
+}
+
+// AbortInfo returns the details of the Move abort that failed the transaction, and false if the transaction didn't
+// fail with a Move abort
+func (o *UserTransaction) AbortInfo() (*AbortInfo, bool) {
+	if o.Success {
+		return nil, false
+	}
+	return ParseAbortInfo(o.VmStatus)
+}
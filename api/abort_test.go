@@ -0,0 +1,44 @@
+package api
+
+import (
+	"github.com/aptos-labs/aptos-go-sdk/internal/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseAbortInfo(t *testing.T) {
+	info, ok := ParseAbortInfo("Move abort in 0x1::coin: EINSUFFICIENT_BALANCE(0x10006): Not enough coins to complete transaction")
+	assert.True(t, ok)
+	assert.Equal(t, &types.AccountOne, info.Address)
+	assert.Equal(t, "coin", info.Module)
+	assert.Equal(t, uint64(0x10006), info.Code)
+	assert.Equal(t, "EINSUFFICIENT_BALANCE", info.ReasonName)
+	assert.Equal(t, "Not enough coins to complete transaction", info.Description)
+
+	info, ok = ParseAbortInfo("Move abort in 0x1234::my_module: 0x2a")
+	assert.True(t, ok)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000001234", info.Address.String())
+	assert.Equal(t, "my_module", info.Module)
+	assert.Equal(t, uint64(42), info.Code)
+	assert.Empty(t, info.ReasonName)
+
+	info, ok = ParseAbortInfo("Move abort: code 0x1")
+	assert.True(t, ok)
+	assert.Nil(t, info.Address)
+	assert.Empty(t, info.Module)
+	assert.Equal(t, uint64(1), info.Code)
+
+	_, ok = ParseAbortInfo("Executed successfully")
+	assert.False(t, ok)
+	_, ok = ParseAbortInfo("Out of gas")
+	assert.False(t, ok)
+
+	txn := &UserTransaction{Success: false, VmStatus: "Move abort in 0x1::coin: 0x10006"}
+	info, ok = txn.AbortInfo()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(0x10006), info.Code)
+
+	txn = &UserTransaction{Success: true, VmStatus: "Executed successfully"}
+	_, ok = txn.AbortInfo()
+	assert.False(t, ok)
+}
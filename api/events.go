@@ -41,6 +41,40 @@ type Event struct {
 	Data           map[string]any // Data is the event data, a map of field name to value, this should match it's on-chain struct representation
 }
 
+// UnmarshalData deserializes the event's data into a typed value, such as a struct with JSON tags matching the on-chain
+// struct fields.  Note that u64, u128, and u256 values are strings in JSON, so use [U64] or string fields for them.
+//
+//	type withdraw struct {
+//		Store  string `json:"store"`
+//		Amount U64    `json:"amount"`
+//	}
+//	data := withdraw{}
+//	err := event.UnmarshalData(&data)
+func (o *Event) UnmarshalData(v any) error {
+	var data any = o.Data
+	if anyData, ok := o.Data[AnyDataName]; ok && len(o.Data) == 1 {
+		data = anyData
+	}
+	// The data has already been parsed from JSON, so round trip it into the given type
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, v)
+}
+
+// FilterEventsByType returns the events with the given type, in order.  The type must match the format used by the
+// node API e.g. 0x1::fungible_asset::Withdraw
+func FilterEventsByType(events []*Event, typeTag string) []*Event {
+	filtered := make([]*Event, 0)
+	for _, event := range events {
+		if event.Type == typeTag {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
 // region Event JSON
 const (
 	AnyDataName = "__any_data__"
@@ -78,3 +78,79 @@ func TestEvent_V2_Other(t *testing.T) {
 	assert.Equal(t, uint64(0), data.Guid.CreationNumber)
 	assert.Equal(t, &types.AccountZero, data.Guid.AccountAddress)
 }
+
+func TestEvent_UnmarshalData(t *testing.T) {
+	testJson := `[{
+		"type": "0x1::fungible_asset::Withdraw",
+		"guid": {
+			"account_address": "0x0",
+			"creation_number": "0"
+		},
+		"sequence_number": "0",
+		"data": {
+			"store": "0x1234123412341234123412341234123412341234123412341234123412341234",
+			"amount": "1000"
+		}
+	},{
+		"type": "vector<u64>",
+		"guid": {
+			"account_address": "0x0",
+			"creation_number": "0"
+		},
+		"sequence_number": "0",
+		"data": ["0","1","2"]
+	},{
+		"type": "0x1::fungible_asset::Withdraw",
+		"guid": {
+			"account_address": "0x0",
+			"creation_number": "0"
+		},
+		"sequence_number": "0",
+		"data": {
+			"store": "0x1234123412341234123412341234123412341234123412341234123412341234",
+			"amount": "5"
+		}
+	}]`
+	var events []*Event
+	err := json.Unmarshal([]byte(testJson), &events)
+	assert.NoError(t, err)
+
+	withdraws := FilterEventsByType(events, "0x1::fungible_asset::Withdraw")
+	assert.Len(t, withdraws, 2)
+	assert.Empty(t, FilterEventsByType(events, "0x1::fungible_asset::Deposit"))
+
+	type withdraw struct {
+		Store  types.AccountAddress `json:"store"`
+		Amount U64                  `json:"amount"`
+	}
+	data := withdraw{}
+	err = withdraws[1].UnmarshalData(&data)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), data.Amount.ToUint64())
+	assert.Equal(t, "0x1234123412341234123412341234123412341234123412341234123412341234", data.Store.String())
+
+	// Non-struct data is unmarshalled directly
+	var values []U64
+	err = events[1].UnmarshalData(&values)
+	assert.NoError(t, err)
+	assert.Equal(t, []U64{0, 1, 2}, values)
+}
+
+func TestUserTransaction_FindEvent(t *testing.T) {
+	txn := &UserTransaction{
+		Events: []*Event{
+			{Type: "0x1::fungible_asset::Withdraw", Data: map[string]any{"amount": "1"}},
+			{Type: "0x1::fungible_asset::Deposit", Data: map[string]any{"amount": "2"}},
+			{Type: "0x1::fungible_asset::Deposit", Data: map[string]any{"amount": "3"}},
+		},
+	}
+	event, ok := txn.FindEvent("0x1::fungible_asset::Deposit")
+	assert.True(t, ok)
+	assert.Equal(t, "2", event.Data["amount"])
+	assert.True(t, txn.HasEvent("0x1::fungible_asset::Withdraw"))
+
+	event, ok = txn.FindEvent("0x1::multisig_account::TransactionExecutionFailed")
+	assert.False(t, ok)
+	assert.Nil(t, event)
+	assert.False(t, txn.HasEvent("0x1::multisig_account::TransactionExecutionFailed"))
+}
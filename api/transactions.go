@@ -338,6 +338,38 @@ func (o *UserTransaction) TxnVersion() *uint64 {
 	return &o.Version
 }
 
+// octasPerAPT is the number of octas, the smallest unit of APT, in one APT
+const octasPerAPT = 100_000_000
+
+// Fee is the fee paid for the transaction in octas, which is the gas used times the gas unit price.  For simulations,
+// this is the estimated fee.
+func (o *UserTransaction) Fee() uint64 {
+	return o.GasUsed * o.GasUnitPrice
+}
+
+// FeeAPT is the fee paid for the transaction in APT, where 1 APT is 10^8 octas.  This is for display, as a float64 can't
+// represent every amount in octas exactly, use [UserTransaction.Fee] for any arithmetic.
+func (o *UserTransaction) FeeAPT() float64 {
+	return float64(o.Fee()) / octasPerAPT
+}
+
+// FindEvent returns the first event emitted by the transaction with the given type, and false if there isn't one.  The
+// type must match the format used by the node API e.g. 0x1::fungible_asset::Withdraw
+func (o *UserTransaction) FindEvent(typeTag string) (*Event, bool) {
+	for _, event := range o.Events {
+		if event.Type == typeTag {
+			return event, true
+		}
+	}
+	return nil, false
+}
+
+// HasEvent returns true if the transaction emitted an event with the given type
+func (o *UserTransaction) HasEvent(typeTag string) bool {
+	_, ok := o.FindEvent(typeTag)
+	return ok
+}
+
 // UnmarshalJSON unmarshals the [UserTransaction] from JSON handling conversion between types
 func (o *UserTransaction) UnmarshalJSON(b []byte) error {
 	type inner struct {
@@ -812,11 +844,21 @@ type BatchSubmitTransactionResponse struct {
 // BatchSubmitTransactionFailure is a failure of a transaction in a batch submission,
 type BatchSubmitTransactionFailure struct {
 	// Error is the error that occurred when submitting the transaction
-	Error Error
+	Error Error `json:"error"`
 	//TransactionIndex is the index of submitted transactions that failed
 	TransactionIndex uint32 `json:"transaction_index"`
 }
 
+// Failure returns the error for the transaction at the given index in the submitted batch, and false if it was accepted
+func (o *BatchSubmitTransactionResponse) Failure(index uint32) (*Error, bool) {
+	for i := range o.TransactionFailures {
+		if o.TransactionFailures[i].TransactionIndex == index {
+			return &o.TransactionFailures[i].Error, true
+		}
+	}
+	return nil, false
+}
+
 // BlockEndInfo is the information about the block gas
 type BlockEndInfo struct {
 	BlockGasLimitReached        bool   `json:"block_gas_limit_reached"`         // BlockGasLimitReached is true if the block gas limit was reached.
@@ -559,6 +559,8 @@ func TestTransaction_UserTransaction(t *testing.T) {
 	assert.Equal(t, uint64(100), txn.GasUnitPrice)
 	assert.Equal(t, uint64(2018), txn.MaxGasAmount)
 	assert.Equal(t, uint64(1719968695), txn.ExpirationTimestampSecs)
+	assert.Equal(t, uint64(500), txn.Fee())
+	assert.Equal(t, 0.000005, txn.FeeAPT())
 
 	// TODO: test some more
 
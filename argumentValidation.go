@@ -0,0 +1,221 @@
+package aptos
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/aptos-labs/aptos-go-sdk/api"
+)
+
+// ValidateArgs checks Go argument values against a function's ABI before they are serialized and submitted, so a
+// mistake such as a string where a u64 is expected is reported locally with the argument that is wrong.
+//
+// Signer parameters are skipped, as they are provided by the transaction.  Any generic type parameters e.g. T0 are
+// checked against typeArgs.
+//
+// The accepted Go values for each Move type are:
+//   - bool as bool
+//   - u8, u16, u32, u64, u128, u256 as any Go integer, big.Int, or a decimal string, within range
+//   - address, 0x1::object::Object<T> as AccountAddress, or a string address
+//   - vector<u8> as []byte, or a hex string
+//   - other vectors as a slice or array of values for the inner type
+//   - 0x1::string::String as string
+//   - 0x1::option::Option<T> as nil, or a value for the inner type
+//
+// Pointers to any of these are also accepted.
+//
+//	module, _ := client.AccountModule(AccountOne, "aptos_account")
+//	function := module.Abi.ExposedFunctions[0]
+//	err := ValidateArgs(function, nil, []any{receiver, uint64(100)})
+func ValidateArgs(abi *api.MoveFunction, typeArgs []TypeTag, args []any) error {
+	if abi == nil {
+		return fmt.Errorf("function ABI is nil")
+	}
+	if len(typeArgs) != len(abi.GenericTypeParams) {
+		return fmt.Errorf("function %s expects %d type arguments, got %d", abi.Name, len(abi.GenericTypeParams), len(typeArgs))
+	}
+
+	// Signers are not passed as arguments, they are provided by the transaction
+	argTypes := make([]*TypeTag, 0, len(abi.Params))
+	for _, param := range abi.Params {
+		param = strings.TrimPrefix(strings.TrimPrefix(param, "&mut "), "&")
+		typeTag, err := parseTypeTagWithGenerics(param)
+		if err != nil {
+			return fmt.Errorf("function %s has unparseable parameter type %s: %w", abi.Name, param, err)
+		}
+		if typeTag.Value.GetType() == TypeTagSigner {
+			continue
+		}
+		argTypes = append(argTypes, typeTag)
+	}
+	if len(argTypes) != len(args) {
+		return fmt.Errorf("function %s expects %d arguments, got %d", abi.Name, len(argTypes), len(args))
+	}
+
+	for i, argType := range argTypes {
+		err := validateMoveArg(args[i], argType, typeArgs)
+		if err != nil {
+			return fmt.Errorf("function %s argument %d (%s): %w", abi.Name, i, argType.String(), err)
+		}
+	}
+	return nil
+}
+
+// validateMoveArg checks a single Go value against a Move type, see [ValidateArgs]
+func validateMoveArg(arg any, typeTag *TypeTag, typeArgs []TypeTag) error {
+	// Options are the only type that can be nil
+	if inner, ok := typeTag.Value.(*StructTag); ok && inner.Address == AccountOne && inner.Module == "option" && inner.Name == "Option" && len(inner.TypeParams) == 1 {
+		if isNilArg(arg) {
+			return nil
+		}
+		return validateMoveArg(arg, &inner.TypeParams[0], typeArgs)
+	}
+	if isNilArg(arg) {
+		return fmt.Errorf("expected %s, got nil", typeTag.String())
+	}
+	arg = derefArg(arg)
+
+	switch inner := typeTag.Value.(type) {
+	case *BoolTag:
+		if _, ok := arg.(bool); !ok {
+			return fmt.Errorf("expected bool, got %T", arg)
+		}
+		return nil
+	case *U8Tag:
+		return validateUintArg(arg, 8)
+	case *U16Tag:
+		return validateUintArg(arg, 16)
+	case *U32Tag:
+		return validateUintArg(arg, 32)
+	case *U64Tag:
+		return validateUintArg(arg, 64)
+	case *U128Tag:
+		return validateUintArg(arg, 128)
+	case *U256Tag:
+		return validateUintArg(arg, 256)
+	case *AddressTag:
+		return validateAddressArg(arg)
+	case *genericTag:
+		if inner.Num >= uint64(len(typeArgs)) {
+			return fmt.Errorf("missing type argument for %s", inner.String())
+		}
+		return validateMoveArg(arg, &typeArgs[inner.Num], typeArgs)
+	case *VectorTag:
+		if _, ok := inner.TypeParam.Value.(*U8Tag); ok {
+			switch value := arg.(type) {
+			case []byte:
+				return nil
+			case string:
+				if _, err := ParseHex(value); err != nil {
+					return fmt.Errorf("expected hex string for vector<u8>: %w", err)
+				}
+				return nil
+			}
+		}
+		reflected := reflect.ValueOf(arg)
+		if reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array {
+			return fmt.Errorf("expected %s, got %T", typeTag.String(), arg)
+		}
+		for i := range reflected.Len() {
+			err := validateMoveArg(reflected.Index(i).Interface(), &inner.TypeParam, typeArgs)
+			if err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		return nil
+	case *StructTag:
+		if inner.Address == AccountOne {
+			switch {
+			case inner.Module == "string" && inner.Name == "String":
+				if _, ok := arg.(string); !ok {
+					return fmt.Errorf("expected string, got %T", arg)
+				}
+				return nil
+			case inner.Module == "object" && inner.Name == "Object":
+				return validateAddressArg(arg)
+			}
+		}
+		return fmt.Errorf("struct %s can't be passed as an argument", typeTag.String())
+	default:
+		return fmt.Errorf("type %s can't be passed as an argument", typeTag.String())
+	}
+}
+
+// validateUintArg checks that the value is an integer that fits in an unsigned integer of the given bits
+func validateUintArg(arg any, bits int) error {
+	var value *big.Int
+	switch typed := arg.(type) {
+	case big.Int:
+		value = &typed
+	case *big.Int:
+		value = typed
+	case string:
+		var ok bool
+		value, ok = new(big.Int).SetString(typed, 10)
+		if !ok {
+			return fmt.Errorf("expected decimal string for u%d, got %q", bits, typed)
+		}
+	default:
+		reflected := reflect.ValueOf(arg)
+		switch reflected.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			value = big.NewInt(reflected.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			value = new(big.Int).SetUint64(reflected.Uint())
+		default:
+			return fmt.Errorf("expected integer for u%d, got %T", bits, arg)
+		}
+	}
+
+	if value.Sign() < 0 || value.BitLen() > bits {
+		return fmt.Errorf("value %s out of range for u%d", value.String(), bits)
+	}
+	return nil
+}
+
+// validateAddressArg checks that the value is an address, or a string that parses as one
+func validateAddressArg(arg any) error {
+	switch value := arg.(type) {
+	case AccountAddress:
+		return nil
+	case string:
+		address := AccountAddress{}
+		if err := address.ParseStringRelaxed(value); err != nil {
+			return fmt.Errorf("invalid address %q: %w", value, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("expected address, got %T", arg)
+	}
+}
+
+// isNilArg checks for both an untyped nil and a nil pointer or map
+func isNilArg(arg any) bool {
+	if arg == nil {
+		return true
+	}
+	reflected := reflect.ValueOf(arg)
+	switch reflected.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Interface:
+		// A nil slice isn't included, as it's a valid empty vector
+		return reflected.IsNil()
+	default:
+		return false
+	}
+}
+
+// derefArg follows pointers to the underlying value, except *big.Int which is handled directly
+func derefArg(arg any) any {
+	for {
+		if _, ok := arg.(*big.Int); ok {
+			return arg
+		}
+		reflected := reflect.ValueOf(arg)
+		if reflected.Kind() != reflect.Pointer || reflected.IsNil() {
+			return arg
+		}
+		arg = reflected.Elem().Interface()
+	}
+}
@@ -0,0 +1,109 @@
+package aptos
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateArgs(t *testing.T) {
+	transferCoins := &api.MoveFunction{
+		Name:              "transfer_coins",
+		IsEntry:           true,
+		GenericTypeParams: []*api.GenericTypeParam{{}},
+		Params:            []string{"&signer", "address", "u64"},
+	}
+	typeArgs := []TypeTag{AptosCoinTypeTag}
+
+	assert.NoError(t, ValidateArgs(transferCoins, typeArgs, []any{AccountOne, uint64(100)}))
+	assert.NoError(t, ValidateArgs(transferCoins, typeArgs, []any{&AccountOne, 100}))
+	assert.NoError(t, ValidateArgs(transferCoins, typeArgs, []any{"0x1", "18446744073709551615"}))
+
+	// Arity
+	assert.ErrorContains(t, ValidateArgs(transferCoins, typeArgs, []any{AccountOne}), "expects 2 arguments, got 1")
+	assert.ErrorContains(t, ValidateArgs(transferCoins, nil, []any{AccountOne, uint64(100)}), "expects 1 type arguments, got 0")
+	assert.Error(t, ValidateArgs(nil, nil, nil))
+
+	// Wrong types, naming the argument
+	err := ValidateArgs(transferCoins, typeArgs, []any{AccountOne, "one hundred"})
+	assert.ErrorContains(t, err, "argument 1 (u64)")
+	assert.Error(t, ValidateArgs(transferCoins, typeArgs, []any{AccountOne, true}))
+	assert.Error(t, ValidateArgs(transferCoins, typeArgs, []any{AccountOne, -1}))
+	assert.Error(t, ValidateArgs(transferCoins, typeArgs, []any{AccountOne, "18446744073709551616"}))
+	assert.Error(t, ValidateArgs(transferCoins, typeArgs, []any{AccountOne, nil}))
+	assert.ErrorContains(t, ValidateArgs(transferCoins, typeArgs, []any{"not an address", 1}), "argument 0 (address)")
+	assert.Error(t, ValidateArgs(transferCoins, typeArgs, []any{uint64(1), 1}))
+}
+
+func TestValidateArgsTypes(t *testing.T) {
+	function := &api.MoveFunction{
+		Name:              "everything",
+		GenericTypeParams: []*api.GenericTypeParam{{}},
+		Params: []string{
+			"bool",
+			"u8",
+			"u128",
+			"u256",
+			"vector<u8>",
+			"vector<u16>",
+			"vector<vector<address>>",
+			"0x1::string::String",
+			"0x1::option::Option<u32>",
+			"0x1::object::Object<0x1::fungible_asset::Metadata>",
+			"T0",
+		},
+	}
+	typeArgs := []TypeTag{{Value: &BoolTag{}}}
+	valid := []any{
+		true,
+		uint8(255),
+		new(big.Int).Lsh(big.NewInt(1), 127),
+		*big.NewInt(5),
+		[]byte{1, 2},
+		[]uint16{1, math.MaxUint16},
+		[][]AccountAddress{{AccountOne}, {}},
+		"hello",
+		nil,
+		AccountThree,
+		false,
+	}
+	assert.NoError(t, ValidateArgs(function, typeArgs, valid))
+
+	// Options accept the inner type, and vectors accept nil slices and hex
+	withSome := append([]any{}, valid...)
+	withSome[8] = uint32(5)
+	withSome[4] = "0x0102"
+	withSome[5] = []uint16(nil)
+	assert.NoError(t, ValidateArgs(function, typeArgs, withSome))
+
+	invalid := map[int]any{
+		0:  1,
+		1:  256,
+		2:  new(big.Int).Lsh(big.NewInt(1), 128),
+		3:  big.NewInt(-1),
+		4:  "not hex",
+		5:  []uint32{math.MaxUint16 + 1},
+		6:  []AccountAddress{AccountOne},
+		7:  []byte("hello"),
+		8:  "five",
+		9:  "0xZZ",
+		10: 0,
+	}
+	for index, value := range invalid {
+		args := append([]any{}, valid...)
+		args[index] = value
+		assert.Error(t, ValidateArgs(function, typeArgs, args), "argument %d", index)
+	}
+
+	// Element errors name the element
+	args := append([]any{}, valid...)
+	args[6] = [][]any{{AccountOne, 5}}
+	assert.ErrorContains(t, ValidateArgs(function, typeArgs, args), "element 0: element 1")
+
+	// Other structs can't be arguments
+	structFunction := &api.MoveFunction{Name: "f", Params: []string{"0x1::coin::Coin<0x1::aptos_coin::AptosCoin>"}}
+	assert.ErrorContains(t, ValidateArgs(structFunction, nil, []any{[]byte{}}), "can't be passed as an argument")
+}
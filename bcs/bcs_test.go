@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"math/big"
 	"testing"
+	"time"
 )
 
 type TestStruct struct {
@@ -441,6 +442,49 @@ func Test_SerializeOptional(t *testing.T) {
 	assert.Nil(t, desValue2)
 }
 
+func Test_SerializeOptionalVectors(t *testing.T) {
+	// Option<vector<u8>>
+	someBytes := []byte{0x01, 0x02}
+	serialized, err := SerializeSingle(func(ser *Serializer) {
+		SerializeOption(ser, &someBytes, (*Serializer).WriteBytes)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02, 0x01, 0x02}, serialized)
+	desBytes := DeserializeOption(NewDeserializer(serialized), func(des *Deserializer, out *[]byte) {
+		*out = des.ReadBytes()
+	})
+	assert.Equal(t, &someBytes, desBytes)
+
+	// Some(empty vector) is not the same as None
+	emptyBytes := []byte{}
+	serialized, err = SerializeSingle(func(ser *Serializer) {
+		SerializeOption(ser, &emptyBytes, (*Serializer).WriteBytes)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x00}, serialized)
+
+	serialized, err = SerializeSingle(func(ser *Serializer) {
+		SerializeOption[[]byte](ser, nil, (*Serializer).WriteBytes)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00}, serialized)
+	assert.Nil(t, DeserializeOption(NewDeserializer(serialized), func(des *Deserializer, out *[]byte) {
+		*out = des.ReadBytes()
+	}))
+
+	// Option<String>
+	someString := "hi"
+	serialized, err = SerializeSingle(func(ser *Serializer) {
+		SerializeOption(ser, &someString, (*Serializer).WriteString)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02, 'h', 'i'}, serialized)
+	desString := DeserializeOption(NewDeserializer(serialized), func(des *Deserializer, out *string) {
+		*out = des.ReadString()
+	})
+	assert.Equal(t, &someString, desString)
+}
+
 func Test_NilStructs(t *testing.T) {
 	ser := Serializer{}
 	ser.Struct(nil)
@@ -499,3 +543,214 @@ func helperBigInt(t *testing.T, serialized []string, deserialized []*big.Int, se
 		assert.Equal(t, 0, deserialized[i].Cmp(&actual))
 	}
 }
+
+func Test_SerializeMap(t *testing.T) {
+	input := map[string]uint8{"aa": 3, "b": 2, "a": 1}
+	serializeKey := func(ser *Serializer, key string) {
+		ser.WriteString(key)
+	}
+	serializeValue := func(ser *Serializer, value uint8) {
+		ser.U8(value)
+	}
+
+	// Sorted by serialized key bytes, so the length prefix puts "aa" last
+	expected := []byte{0x03, 0x01, 'a', 0x01, 0x01, 'b', 0x02, 0x02, 'a', 'a', 0x03}
+	for range 10 {
+		bytes, err := SerializeSingle(func(ser *Serializer) {
+			SerializeMap(ser, input, serializeKey, serializeValue)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, bytes)
+	}
+
+	// Keys that serialize the same are not allowed
+	_, err := SerializeSingle(func(ser *Serializer) {
+		SerializeMap(ser, map[int]uint8{1: 1, 2: 2}, func(ser *Serializer, key int) {
+			ser.U8(0)
+		}, serializeValue)
+	})
+	assert.Error(t, err)
+
+	// Errors from values are passed up
+	_, err = SerializeSingle(func(ser *Serializer) {
+		SerializeMap(ser, input, serializeKey, func(ser *Serializer, value uint8) {
+			ser.SetError(errors.New("bad value"))
+		})
+	})
+	assert.Error(t, err)
+}
+
+func Test_SerializeSequenceOf(t *testing.T) {
+	bytes, err := SerializeSequenceOf([]uint16{1, 2}, (*Serializer).U16)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0x01, 0x00, 0x02, 0x00}, bytes)
+
+	bytes, err = SerializeSequenceOf([]string{}, (*Serializer).WriteString)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00}, bytes)
+
+	_, err = SerializeSequenceOf([]uint8{1}, func(ser *Serializer, item uint8) {
+		ser.SetError(errors.New("bad item"))
+	})
+	assert.Error(t, err)
+}
+
+func Test_TimeMicros(t *testing.T) {
+	input := time.UnixMicro(1_714_158_778_123_456)
+	bytes, err := SerializeSingle(func(ser *Serializer) {
+		ser.TimeMicros(input)
+	})
+	assert.NoError(t, err)
+	expected, err := SerializeU64(1_714_158_778_123_456)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, bytes)
+
+	des := NewDeserializer(bytes)
+	output := des.TimeMicros()
+	assert.NoError(t, des.Error())
+	assert.True(t, input.Equal(output))
+
+	// Times before the epoch, and out of range values are errors
+	_, err = SerializeSingle(func(ser *Serializer) {
+		ser.TimeMicros(time.Unix(-1, 0))
+	})
+	assert.Error(t, err)
+	des = NewDeserializer([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	des.TimeMicros()
+	assert.Error(t, des.Error())
+}
+
+func Test_SerializerErrors(t *testing.T) {
+	maxU128 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	tooLarge := new(big.Int).Add(maxU128, big.NewInt(1))
+
+	// The largest value fits
+	_, err := SerializeSingle(func(ser *Serializer) {
+		ser.U128(*maxU128)
+	})
+	assert.NoError(t, err)
+
+	// Out of range values are errors, not panics
+	for _, v := range []*big.Int{tooLarge, big.NewInt(-1)} {
+		ser := &Serializer{}
+		ser.U64(1)
+		ser.U128(*v)
+		assert.Error(t, ser.Error())
+		assert.Nil(t, ser.ToBytes())
+	}
+	_, err = SerializeSingle(func(ser *Serializer) {
+		ser.U256(*new(big.Int).Lsh(big.NewInt(1), 256))
+	})
+	assert.Error(t, err)
+
+	// Only the first error is kept
+	ser := &Serializer{}
+	ser.U128(*tooLarge)
+	ser.Struct(nil)
+	assert.ErrorContains(t, ser.Error(), "128-bit")
+	assert.NotContains(t, ser.Error().Error(), "nil")
+
+	// Sequences wrap the error with where it happened
+	ser = &Serializer{}
+	SerializeSequenceWithFunction([]*big.Int{big.NewInt(1), tooLarge}, ser, func(ser *Serializer, item *big.Int) {
+		ser.U128(*item)
+	})
+	assert.ErrorContains(t, ser.Error(), "sequence[1]")
+	assert.ErrorContains(t, ser.Error(), "128-bit")
+
+	// Reset clears the error
+	ser = &Serializer{}
+	ser.U128(*big.NewInt(-1))
+	assert.Error(t, ser.Error())
+	ser.Reset()
+	assert.NoError(t, ser.Error())
+	ser.U8(1)
+	assert.Equal(t, []byte{1}, ser.ToBytes())
+}
+
+// nestedStruct nests itself through Struct, depth times
+type nestedStruct struct {
+	depth uint8
+}
+
+func (st *nestedStruct) MarshalBCS(ser *Serializer) {
+	ser.U8(st.depth)
+}
+
+func (st *nestedStruct) UnmarshalBCS(des *Deserializer) {
+	st.depth = des.U8()
+	if st.depth > 0 {
+		des.Struct(&nestedStruct{})
+	}
+}
+
+func Test_DeserializerLimits(t *testing.T) {
+	var limitErr *LimitExceededError
+
+	// A huge length prefix with no bytes behind it must fail, without allocating
+	des := NewDeserializer([]byte{0xff, 0xff, 0xff, 0xff, 0x07})
+	assert.Nil(t, des.ReadBytes())
+	assert.Error(t, des.Error())
+	des = NewDeserializer([]byte{0xff, 0xff, 0xff, 0xff, 0x07})
+	assert.Nil(t, DeserializeSequence[TestStruct](des))
+	assert.Error(t, des.Error())
+
+	// Beyond the default length
+	des = NewDeserializer([]byte{0xff, 0xff, 0xff, 0xff, 0x0f})
+	des.ReadBytes()
+	assert.True(t, errors.As(des.Error(), &limitErr))
+	assert.Equal(t, "sequence length", limitErr.Limit)
+	assert.Equal(t, int(DefaultMaxSequenceLength), limitErr.Max)
+
+	// Configured length
+	des = NewDeserializer([]byte{0x03, 0x01, 0x02, 0x03})
+	des.MaxSequenceLength = 2
+	des.ReadBytes()
+	assert.True(t, errors.As(des.Error(), &limitErr))
+	assert.Equal(t, 3, limitErr.Value)
+	assert.Equal(t, 2, limitErr.Max)
+
+	des = NewDeserializer([]byte{0x03, 0x01, 0x01, 0x02, 0x00, 0x03, 0x01})
+	des.MaxSequenceLength = 2
+	DeserializeSequence[TestStruct](des)
+	assert.True(t, errors.As(des.Error(), &limitErr))
+
+	des = NewDeserializer([]byte{0x02, 0x01, 0x01, 0x02, 0x00})
+	des.MaxSequenceLength = 2
+	assert.Equal(t, []TestStruct{{1, true}, {2, false}}, DeserializeSequence[TestStruct](des))
+	assert.NoError(t, des.Error())
+
+	// Nested structs, depth 3 is 4 levels of Struct
+	nested := []byte{0x03, 0x02, 0x01, 0x00}
+	des = NewDeserializer(nested)
+	des.MaxContainerDepth = 4
+	des.Struct(&nestedStruct{})
+	assert.NoError(t, des.Error())
+
+	des = NewDeserializer(nested)
+	des.MaxContainerDepth = 3
+	des.Struct(&nestedStruct{})
+	assert.True(t, errors.As(des.Error(), &limitErr))
+	assert.Equal(t, "container depth", limitErr.Limit)
+	assert.Equal(t, 4, limitErr.Value)
+	assert.Equal(t, 3, limitErr.Max)
+
+	// Default depth through the convenience function
+	deep := make([]byte, DefaultMaxContainerDepth+1)
+	for i := range deep {
+		deep[i] = uint8(min(len(deep)-1-i, 0xff))
+	}
+	err := Deserialize(&nestedStruct{}, deep)
+	assert.True(t, errors.As(err, &limitErr))
+	assert.NoError(t, Deserialize(&nestedStruct{}, deep[1:]))
+
+	// Sequences count towards depth
+	des = NewDeserializer([]byte{0x01, 0x01, 0x01, 0x07})
+	des.MaxContainerDepth = 1
+	DeserializeSequenceWithFunction(des, func(des *Deserializer, out *[]uint8) {
+		*out = DeserializeSequenceWithFunction(des, func(des *Deserializer, out *uint8) {
+			*out = des.U8()
+		})
+	})
+	assert.True(t, errors.As(des.Error(), &limitErr))
+}
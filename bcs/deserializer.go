@@ -3,8 +3,10 @@ package bcs
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/big"
 	"slices"
+	"time"
 )
 
 // Deserializer is a type to deserialize a known set of bytes.
@@ -18,10 +20,41 @@ import (
 //	if deserializer.Error() != nil {
 //		return deserializer.Error()
 //	}
+//
+// When deserializing untrusted bytes, MaxSequenceLength and MaxContainerDepth can be lowered to bound the work done,
+// a zero value uses [DefaultMaxSequenceLength] and [DefaultMaxContainerDepth] respectively.
 type Deserializer struct {
 	source []byte // Underlying data to parse
 	pos    int    // Current position in the buffer
 	err    error  // Any error that has happened so far
+	depth  int    // Current nesting of structs and sequences
+
+	// MaxSequenceLength is the largest length prefix accepted for bytes, strings, and sequences
+	MaxSequenceLength uint32
+	// MaxContainerDepth is the deepest nesting of structs and sequences accepted
+	MaxContainerDepth int
+}
+
+// DefaultMaxSequenceLength is the default for [Deserializer.MaxSequenceLength], matching the Rust BCS implementation
+const DefaultMaxSequenceLength = uint32(math.MaxInt32)
+
+// DefaultMaxContainerDepth is the default for [Deserializer.MaxContainerDepth], matching the Rust BCS implementation
+const DefaultMaxContainerDepth = 500
+
+// LimitExceededError is the error set on a [Deserializer] when a length prefix or nesting exceeds its limits
+//
+//	var limitErr *LimitExceededError
+//	if errors.As(des.Error(), &limitErr) {
+//		// Reject the input
+//	}
+type LimitExceededError struct {
+	Limit string // Which limit was exceeded, "sequence length" or "container depth"
+	Value int    // The value that was read
+	Max   int    // The configured limit
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("bcs %s %d exceeds the maximum of %d", e.Limit, e.Value, e.Max)
 }
 
 // NewDeserializer creates a new Deserializer from a byte array.
@@ -150,6 +183,19 @@ func (des *Deserializer) U256() big.Int {
 	return des.deserializeUBigint("u256", 32)
 }
 
+// TimeMicros deserializes a u64 of microseconds since the Unix epoch into a time, see [Serializer.TimeMicros]
+func (des *Deserializer) TimeMicros() time.Time {
+	micros := des.U64()
+	if des.Error() != nil {
+		return time.Time{}
+	}
+	if micros > math.MaxInt64 {
+		des.setError("time micros %d out of range", micros)
+		return time.Time{}
+	}
+	return time.UnixMicro(int64(micros))
+}
+
 // Uleb128 deserializes a 32-bit integer from a variable length [Unsigned LEB128]
 //
 // [Unsigned LEB128]: https://en.wikipedia.org/wiki/LEB128#Unsigned_LEB128
@@ -188,11 +234,17 @@ func (des *Deserializer) Uleb128() uint32 {
 }
 
 // ReadBytes reads bytes prefixed with a length
+//
+// The length is checked against [Deserializer.MaxSequenceLength] and the remaining bytes before allocating.
 func (des *Deserializer) ReadBytes() []byte {
-	length := des.Uleb128()
+	length := des.SequenceLength()
 	if des.err != nil {
 		return nil
 	}
+	if int(length) > des.Remaining() {
+		des.setError("not enough bytes remaining to deserialize bytes")
+		return nil
+	}
 
 	dest := make([]byte, length)
 	des.readBytes("bytes", int(length), dest)
@@ -229,12 +281,17 @@ func (des *Deserializer) readBytes(typeName string, length int, dest []byte) {
 
 // Struct reads an Unmarshaler implementation from bcs bytes
 //
-// This is used for handling types outside the provided primitives
+// This is used for handling types outside the provided primitives.  Each nested call counts towards
+// [Deserializer.MaxContainerDepth].
 func (des *Deserializer) Struct(v Unmarshaler) {
 	if v == nil {
 		des.setError("cannot deserialize into nil")
 		return
 	}
+	if !des.enterContainer() {
+		return
+	}
+	defer des.exitContainer()
 	v.UnmarshalBCS(des)
 }
 
@@ -257,20 +314,29 @@ func DeserializeSequence[T any](des *Deserializer) []T {
 // DeserializeSequenceWithFunction deserializes any array with the given function
 //
 // This lets you deserialize a whole sequence of any type, and will fail if any member fails.
-// All sequences are prefixed with an Uleb128 length.
+// All sequences are prefixed with an Uleb128 length, which is checked against [Deserializer.MaxSequenceLength].
 func DeserializeSequenceWithFunction[T any](des *Deserializer, deserialize func(des *Deserializer, out *T)) []T {
-	length := des.Uleb128()
+	length := des.SequenceLength()
 	if des.Error() != nil {
 		return nil
 	}
-	out := make([]T, length)
+	if !des.enterContainer() {
+		return nil
+	}
+	defer des.exitContainer()
+
+	// Don't trust the length prefix for the allocation, a crafted prefix would otherwise allocate far more than the
+	// input could ever fill
+	out := make([]T, 0, min(int(length), des.Remaining()))
 	for i := 0; i < int(length); i++ {
-		deserialize(des, &out[i])
+		var item T
+		deserialize(des, &item)
 
 		if des.Error() != nil {
 			des.setError("could not deserialize sequence[%d] member of %w", i, des.Error())
 			return nil
 		}
+		out = append(out, item)
 	}
 	return out
 }
@@ -311,6 +377,53 @@ func DeserializeOption[T any](des *Deserializer, deserialize func(des *Deseriali
 	return nil
 }
 
+// SequenceLength reads a Uleb128 length prefix, and checks it against [Deserializer.MaxSequenceLength].  Use this rather
+// than Uleb128 when reading a custom sequence or map, and don't preallocate more than [Deserializer.Remaining] elements
+// from it, as every element takes at least one byte.
+func (des *Deserializer) SequenceLength() uint32 {
+	length := des.Uleb128()
+	if des.err != nil {
+		return 0
+	}
+	maxLength := des.MaxSequenceLength
+	if maxLength == 0 {
+		maxLength = DefaultMaxSequenceLength
+	}
+	if length > maxLength {
+		des.setLimitError("sequence length", int(length), int(maxLength))
+		return 0
+	}
+	return length
+}
+
+// enterContainer increases the nesting depth, returning false and setting an error if it exceeds MaxContainerDepth.
+// A successful call must be followed by exitContainer.
+func (des *Deserializer) enterContainer() bool {
+	maxDepth := des.MaxContainerDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxContainerDepth
+	}
+	if des.depth >= maxDepth {
+		des.setLimitError("container depth", des.depth+1, maxDepth)
+		return false
+	}
+	des.depth++
+	return true
+}
+
+// exitContainer decreases the nesting depth, see enterContainer
+func (des *Deserializer) exitContainer() {
+	des.depth--
+}
+
+// setLimitError sets a [LimitExceededError], unless there is already an error
+func (des *Deserializer) setLimitError(limit string, value int, maxValue int) {
+	if des.err != nil {
+		return
+	}
+	des.err = &LimitExceededError{Limit: limit, Value: value, Max: maxValue}
+}
+
 // setError overrides the previous error, this can only be called from within the bcs package
 func (des *Deserializer) setError(msg string, args ...any) {
 	if des.err != nil {
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/big"
 	"slices"
+	"time"
 )
 
 // Serializer is a holding type to serialize a set of items into one shared buffer
@@ -13,6 +15,15 @@ import (
 //	serializer := &Serializer{}
 //	serializer.U64(uint64(10))
 //	serializedBytes := serializer.ToBytes()
+//
+// Serialization never panics on bad input, such as a negative U128 or a sequence too long for its length prefix.
+// Instead, the error is recorded and can be checked with [Serializer.Error] once all values are written:
+//
+//	serializer := &Serializer{}
+//	serializer.WriteBytes(userInput)
+//	if serializer.Error() != nil {
+//		return serializer.Error()
+//	}
 type Serializer struct {
 	out bytes.Buffer // current serialized bytes
 	err error        // any error that has occurred during serialization
@@ -36,14 +47,17 @@ func Serialize(value Marshaler) (bytes []byte, err error) {
 	})
 }
 
-// Error the error if serialization has failed at any point
+// Error the error if serialization has failed at any point.  Once set, the output of [Serializer.ToBytes] is not valid.
 func (ser *Serializer) Error() error {
 	return ser.err
 }
 
-// SetError If the data is well-formed but nonsense, [Marshaler.MarshalBCS] code can set the error.
+// SetError If the data is well-formed but nonsense, [Marshaler.MarshalBCS] code can set the error.  Only the first
+// error is kept, later errors are ignored.
 func (ser *Serializer) SetError(err error) {
-	ser.err = err
+	if ser.err == nil {
+		ser.err = err
+	}
 }
 
 // Bool serialize a bool into a single byte, 0x01 for true and 0x00 for false
@@ -62,6 +76,10 @@ func serializeUInt[T uint16 | uint32 | uint64](ser *Serializer, size uint, v T,
 }
 
 func (ser *Serializer) serializeUBigInt(size uint, v *big.Int) {
+	if v.Sign() < 0 || uint(v.BitLen()) > size*8 {
+		ser.SetError(fmt.Errorf("cannot serialize %s as a %d-bit unsigned integer", v, size*8))
+		return
+	}
 	ub := make([]byte, size)
 	v.FillBytes(ub[:])
 	// Reverse, since big.Int outputs bytes in BigEndian
@@ -99,6 +117,17 @@ func (ser *Serializer) U256(v big.Int) {
 	ser.serializeUBigInt(32, &v)
 }
 
+// TimeMicros serialize a time as a u64 of microseconds since the Unix epoch, which is how on-chain timestamps are
+// represented.  Times before the Unix epoch are an error.
+func (ser *Serializer) TimeMicros(v time.Time) {
+	micros := v.UnixMicro()
+	if micros < 0 {
+		ser.SetError(fmt.Errorf("cannot serialize time before the Unix epoch: %s", v))
+		return
+	}
+	ser.U64(uint64(micros))
+}
+
 // Uleb128 serialize an unsigned 32-bit integer as an Uleb128.  This is used specifically for sequence lengths, and enums.
 func (ser *Serializer) Uleb128(val uint32) {
 	for val>>7 != 0 {
@@ -110,10 +139,22 @@ func (ser *Serializer) Uleb128(val uint32) {
 
 // WriteBytes serialize an array of bytes with its length first as an Uleb128.
 func (ser *Serializer) WriteBytes(v []byte) {
-	ser.Uleb128(uint32(len(v)))
+	if !ser.length(len(v)) {
+		return
+	}
 	ser.out.Write(v)
 }
 
+// length serializes a sequence length as an Uleb128, setting an error if it doesn't fit in a u32.  Returns false on error.
+func (ser *Serializer) length(length int) bool {
+	if uint64(length) > math.MaxUint32 {
+		ser.SetError(fmt.Errorf("sequence length %d is greater than the maximum %d", length, uint32(math.MaxUint32)))
+		return false
+	}
+	ser.Uleb128(uint32(length))
+	return true
+}
+
 // WriteString similar to [Serializer.WriteBytes] using the UTF-8 byte representation of the string
 func (ser *Serializer) WriteString(v string) {
 	ser.WriteBytes([]byte(v))
@@ -135,7 +176,12 @@ func (ser *Serializer) Struct(v Marshaler) {
 }
 
 // ToBytes outputs the encoded bytes
+//
+// If [Serializer.Error] is non-nil, the output is nil, as partial output would not deserialize correctly.
 func (ser *Serializer) ToBytes() []byte {
+	if ser.err != nil {
+		return nil
+	}
 	return ser.out.Bytes()
 }
 
@@ -194,12 +240,15 @@ func SerializeSequence[AT []T, T any](array AT, ser *Serializer) {
 //		ser.WriteString(item)
 //	}
 func SerializeSequenceWithFunction[AT []T, T any](array AT, ser *Serializer, serialize func(ser *Serializer, item T)) {
-	ser.Uleb128(uint32(len(array)))
+	if !ser.length(len(array)) {
+		return
+	}
 	for i, v := range array {
 		serialize(ser, v)
 		// Exit early if there's an error
 		if ser.Error() != nil {
-			ser.SetError(fmt.Errorf("could not serialize sequence[%d] member of %T %w", i, v, ser.Error()))
+			// Wrap the first error with where it happened
+			ser.err = fmt.Errorf("could not serialize sequence[%d] member of %T %w", i, v, ser.err)
 			return
 		}
 	}
@@ -228,6 +277,18 @@ func SerializeSequenceOnly[AT []T, T any](input AT) ([]byte, error) {
 	})
 }
 
+// SerializeSequenceOf serializes a sequence into a single value with a custom serialization function for each item.
+// This is useful for building vector arguments of non-[Marshaler] types.
+//
+//	bytes, err := SerializeSequenceOf([]uint64{1, 2, 3}, func(ser *Serializer, item uint64) {
+//		ser.U64(item)
+//	})
+func SerializeSequenceOf[T any](items []T, f func(ser *Serializer, item T)) ([]byte, error) {
+	return SerializeSingle(func(ser *Serializer) {
+		SerializeSequenceWithFunction(items, ser, f)
+	})
+}
+
 // SerializeBool Serializes a single boolean
 //
 //	bytes, _ := SerializeBool(true)
@@ -352,3 +413,51 @@ func SerializeOption[T any](ser *Serializer, input *T, serialize func(ser *Seria
 		SerializeSequenceWithFunction([]T{*input}, ser, serialize)
 	}
 }
+
+// SerializeMap serializes a map in canonical order.  Prefixed with the number of entries, and entries are sorted by
+// their serialized key bytes, so the output is deterministic and matches Move's ordering.
+//
+//	input := map[string]uint64{"b": 2, "a": 1}
+//	ser := &Serializer{}
+//	SerializeMap(ser, input, func(ser *Serializer, key string) {
+//		ser.WriteString(key)
+//	}, func(ser *Serializer, value uint64) {
+//		ser.U64(value)
+//	})
+func SerializeMap[K comparable, V any](ser *Serializer, m map[K]V, kf func(ser *Serializer, key K), vf func(ser *Serializer, value V)) {
+	type entry struct {
+		key   []byte
+		value V
+	}
+	entries := make([]entry, 0, len(m))
+	for k, v := range m {
+		keyBytes, err := SerializeSingle(func(keySer *Serializer) {
+			kf(keySer, k)
+		})
+		if err != nil {
+			ser.SetError(fmt.Errorf("could not serialize map key %v: %w", k, err))
+			return
+		}
+		entries = append(entries, entry{key: keyBytes, value: v})
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return bytes.Compare(a.key, b.key)
+	})
+
+	if !ser.length(len(entries)) {
+		return
+	}
+	for i, e := range entries {
+		if i > 0 && bytes.Equal(entries[i-1].key, e.key) {
+			ser.SetError(fmt.Errorf("duplicate serialized map key %x", e.key))
+			return
+		}
+		ser.FixedBytes(e.key)
+		vf(ser, e.value)
+		if ser.Error() != nil {
+			// Wrap the first error with where it happened
+			ser.err = fmt.Errorf("could not serialize map value for key %x %w", e.key, ser.err)
+			return
+		}
+	}
+}
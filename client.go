@@ -1,11 +1,14 @@
 package aptos
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
 	"github.com/hasura/go-graphql-client"
 )
 
@@ -107,9 +110,35 @@ type AptosRpcClient interface {
 	// Info Retrieves the node info about the network and it's current state
 	Info() (info NodeInfo, err error)
 
+	// LedgerInfo retrieves the node info with every field parsed, including the ledger timestamp as a time
+	//
+	//	info, err := client.LedgerInfo()
+	//	expiration := info.LedgerTimestamp.Add(time.Minute)
+	LedgerInfo() (LedgerInfo, error)
+
+	// VerifyNetwork checks that the node reports the expected chain ID, and returns a [*ChainMismatchError] if it
+	// doesn't.  This is useful to call once on startup, to catch a client pointed at the wrong network.
+	//
+	//	err := client.VerifyNetwork(MainnetConfig.ChainId)
+	//	if errors.Is(err, ErrChainMismatch) {
+	//		// wrong node URL for the network
+	//	}
+	VerifyNetwork(expectedChainId uint8) error
+
 	// Account Retrieves information about the account such as [SequenceNumber] and [crypto.AuthenticationKey]
 	Account(address AccountAddress, ledgerVersion ...uint64) (info AccountInfo, err error)
 
+	// AccountAuthKey retrieves the current [crypto.AuthenticationKey] of the account, which may differ from the address
+	// if the key has been rotated.
+	//
+	// Optionally, a ledgerVersion can be given to get the authentication key at a specific ledger version
+	AccountAuthKey(address AccountAddress, ledgerVersion ...uint64) (*crypto.AuthenticationKey, error)
+
+	// AccountScheme retrieves the [crypto.DeriveScheme] the account currently signs with, from the signature of the
+	// last transaction sent by the account.  Errors if the account hasn't sent any transactions, or if that signature's
+	// key doesn't match the on-chain authentication key.
+	AccountScheme(address AccountAddress) (crypto.DeriveScheme, error)
+
 	// AccountResource Retrieves a single resource given its struct name.
 	//
 	//	address := AccountOne
@@ -136,6 +165,30 @@ type AptosRpcClient interface {
 	// AccountResourcesBCS fetches account resources as raw Move struct BCS blobs in AccountResourceRecord.Data []byte
 	AccountResourcesBCS(address AccountAddress, ledgerVersion ...uint64) (resources []AccountResourceRecord, err error)
 
+	// AccountResourceGroup fetches all members of a resource group stored at an account into JSON-like maps keyed by
+	// the member's type.
+	// For fetching raw Move structs as BCS, See #AccountResourceGroupBCS
+	//
+	//	members, _ := client.AccountResourceGroup(objectAddress, "0x1::object::ObjectGroup")
+	//	objectCore := members["0x1::object::ObjectCore"]
+	AccountResourceGroup(address AccountAddress, groupType string, ledgerVersion ...uint64) (members map[string]map[string]any, err error)
+
+	// AccountResourceGroupBCS fetches all members of a resource group stored at an account as raw Move struct BCS
+	// blobs keyed by the member's type
+	AccountResourceGroupBCS(address AccountAddress, groupType string, ledgerVersion ...uint64) (members map[string][]byte, err error)
+
+	// AccountModule fetches a single module's bytecode and ABI
+	//
+	//	module, _ := client.AccountModule(AccountOne, "coin")
+	AccountModule(address AccountAddress, moduleName string, ledgerVersion ...uint64) (data *api.MoveBytecode, err error)
+
+	// InspectRawTransaction decodes a transaction into a human-readable summary, for review before signing.  This is
+	// useful to verify a MultiAgent or FeePayer transaction built by another party before co-signing it.
+	//
+	//	summary, _ := client.InspectRawTransaction(rawTxn)
+	//	fmt.Println(summary.String())
+	InspectRawTransaction(rawTxn *RawTransaction) (summary *TransactionSummary, err error)
+
 	// BlockByHeight fetches a block by height
 	//
 	//	block, _ := client.BlockByHeight(1, false)
@@ -171,6 +224,14 @@ type AptosRpcClient interface {
 	//	}
 	TransactionByHash(txnHash string) (data *api.Transaction, err error)
 
+	// TransactionStatus gets the status of a transaction by hash, without waiting for it to be committed
+	//
+	//	status, err := client.TransactionStatus("0xabcd")
+	//	if status == TxnStatusPending {
+	//		// known to the mempool, but not committed yet
+	//	}
+	TransactionStatus(txnHash string) (status TxnStatus, err error)
+
 	// TransactionByVersion gets info on a transaction from its LedgerVersion.  It must have been
 	// committed to have a ledger version
 	//
@@ -217,6 +278,51 @@ type AptosRpcClient interface {
 	//	client.AccountTransactions(AccountOne, 1, 100) // Returns 100 transactions for 0x1
 	AccountTransactions(address AccountAddress, start *uint64, limit *uint64) (data []*api.CommittedTransaction, err error)
 
+	// SubscribeAccountTransactions streams new transactions involving the account, until the context is cancelled.  The
+	// node API doesn't support streaming, so this polls every PollPeriod, defaulting to 1 second.
+	//
+	// With an indexer configured, this includes transactions sent by other accounts e.g. incoming transfers.  Without
+	// one, it falls back to polling the node, and only transactions sent by the account are included.
+	//
+	//	txns, _ := client.SubscribeAccountTransactions(ctx, sender.AccountAddress(), PollPeriod(2*time.Second))
+	//	for txn := range txns {
+	//		if txn.Err != nil {
+	//			// polling failed, it will be retried on the next period
+	//			continue
+	//		}
+	//		fmt.Println(txn.Result.Hash())
+	//	}
+	SubscribeAccountTransactions(ctx context.Context, address AccountAddress, options ...any) (txns <-chan ConcResponse[*api.CommittedTransaction], err error)
+
+	// StreamBlockTransactions streams every transaction in the blocks from startHeight to endHeight inclusive, in order.
+	// If endHeight is 0, it follows the head of the chain, checking for new blocks every PollPeriod, defaulting to 1
+	// second.
+	//
+	//	txns, _ := client.StreamBlockTransactions(ctx, 100, 200)
+	//	for txn := range txns {
+	//		if txn.Err != nil {
+	//			// fetching failed, the block will be retried after the poll period
+	//			continue
+	//		}
+	//		fmt.Println(txn.Result.Version())
+	//	}
+	StreamBlockTransactions(ctx context.Context, startHeight uint64, endHeight uint64, options ...any) (txns <-chan ConcResponse[*api.CommittedTransaction], err error)
+
+	// StreamAccountTransactions streams the transactions sent by the account, fetching a page at a time as they are
+	// read.  Accepts options StreamStart, StreamLimit and StreamDescending.  Cancel the context to stop fetching early.
+	//
+	//	ctx, cancel := context.WithCancel(context.Background())
+	//	defer cancel()
+	//	txns, _ := client.StreamAccountTransactions(ctx, address, StreamDescending(true), StreamLimit(500))
+	//	for txn := range txns {
+	//		if txn.Err != nil {
+	//			// fetching failed, the channel is closed after the error
+	//			break
+	//		}
+	//		fmt.Println(txn.Result.Version())
+	//	}
+	StreamAccountTransactions(ctx context.Context, address AccountAddress, options ...any) (txns <-chan ConcResponse[*api.CommittedTransaction], err error)
+
 	// SubmitTransaction Submits an already signed transaction to the blockchain
 	//
 	//	sender := NewEd25519Account()
@@ -239,6 +345,25 @@ type AptosRpcClient interface {
 	//	submitResponse, err := client.SubmitTransaction(signedTxn)
 	SubmitTransaction(signedTransaction *SignedTransaction) (data *api.SubmitTransactionResponse, err error)
 
+	// SubmitTransactionBCS submits an already BCS serialized signed transaction to the blockchain, without decoding it.
+	// This is useful for relaying transactions signed elsewhere, such as by a signing server.
+	//
+	//	signedTxnBytes, _ := signingServer.Sign(rawTxn)
+	//	submitResponse, err := client.SubmitTransactionBCS(signedTxnBytes)
+	SubmitTransactionBCS(signedTxnBytes []byte) (data *api.SubmitTransactionResponse, err error)
+
+	// SubmitTransactionIdempotent submits a signed transaction, unless the node already knows of it, so it is safe to
+	// retry.  Instead of failing with a sequence number error, it returns the status of the existing transaction.
+	//
+	//	hash, status, err := client.SubmitTransactionIdempotent(signedTxn)
+	//	if err != nil {
+	//		return err
+	//	}
+	//	if status == TxnStatusPending {
+	//		userTxn, err := client.WaitForTransaction(hash)
+	//	}
+	SubmitTransactionIdempotent(signedTxn *SignedTransaction) (hash string, status TxnStatus, err error)
+
 	// BatchSubmitTransaction submits a collection of signed transactions to the network in a single request
 	//
 	// It will return the responses in the same order as the input transactions that failed.  If the response is empty, then
@@ -283,8 +408,18 @@ type AptosRpcClient interface {
 	//	}
 	//	rawTxn, _ := client.BuildTransaction(sender.AccountAddress(), txnPayload)
 	//	simResponse, err := client.SimulateTransaction(rawTxn, sender)
+	//
+	// To check whether the transaction succeeds with a specific max gas amount
+	//
+	//	simResponse, err := client.SimulateTransaction(rawTxn, sender, SimulateMaxGas(2000))
 	SimulateTransaction(rawTxn *RawTransaction, sender TransactionSigner, options ...any) (data []*api.UserTransaction, err error)
 
+	// SimulateFeePayerTransaction simulates a MultiAgent or FeePayer transaction using only the public keys of the parties
+	//
+	//	rawTxn, _ := client.BuildTransactionMultiAgent(alice.AccountAddress(), txnPayload, FeePayer(&sponsor.Address))
+	//	simResponse, err := client.SimulateFeePayerTransaction(rawTxn, alice.PubKey(), sponsor.PubKey())
+	SimulateFeePayerTransaction(rawTxn *RawTransactionWithData, senderPubKey crypto.PublicKey, feePayerPubKey crypto.PublicKey, secondarySigners ...crypto.PublicKey) (data *api.UserTransaction, err error)
+
 	// GetChainId Retrieves the ChainId of the network
 	// Note this will be cached forever, or taken directly from the config
 	GetChainId() (chainId uint8, err error)
@@ -309,6 +444,13 @@ type AptosRpcClient interface {
 	//	rawTxn, err := client.BuildTransaction(sender.AccountAddress(), txnPayload)
 	BuildTransaction(sender AccountAddress, payload TransactionPayload, options ...any) (rawTxn *RawTransaction, err error)
 
+	// SequenceManagerFor returns the shared [SequenceManager] for the account, which hands out sequence numbers locally
+	// to build many transactions quickly, and resynchronizes with the node on sequence number errors
+	//
+	//	sequenceNumber, err := client.SequenceManagerFor(sender.AccountAddress()).Next()
+	//	rawTxn, err := client.BuildTransaction(sender.AccountAddress(), txnPayload, SequenceNumber(sequenceNumber))
+	SequenceManagerFor(address AccountAddress) *SequenceManager
+
 	// BuildTransactionMultiAgent Builds a raw transaction for MultiAgent or FeePayer from the payload and fetches any necessary information from on-chain
 	//
 	//	sender := NewEd25519Account()
@@ -366,12 +508,32 @@ type AptosRpcClient interface {
 	//		balance := StrToU64(vals.(any[])[0].(string))
 	View(payload *ViewPayload, ledgerVersion ...uint64) (vals []any, err error)
 
+	// ViewBatch runs multiple view functions concurrently, returning values and errors in the same order as the payloads.
+	//
+	//	vals, errs := client.ViewBatch(ctx, []*ViewPayload{payload1, payload2})
+	//	if errs[0] == nil {
+	//		balance := StrToU64(vals[0][0].(string))
+	//	}
+	ViewBatch(ctx context.Context, payloads []*ViewPayload, ledgerVersion ...uint64) (vals [][]any, errs []error)
+
 	// EstimateGasPrice Retrieves the gas estimate from the network.
 	EstimateGasPrice() (info EstimateGasInfo, err error)
 
 	// AccountAPTBalance retrieves the APT balance in the account
 	AccountAPTBalance(address AccountAddress, ledgerVersion ...uint64) (uint64, error)
 
+	// AccountBalances fetches the APT balances of many accounts concurrently, in octas.  The errors are in the same
+	// order as the accounts, and an account without any APT, including one that doesn't exist, has a balance of 0 rather
+	// than an error.
+	//
+	//	balances, errs := client.AccountBalances(ctx, addresses)
+	AccountBalances(ctx context.Context, accounts []AccountAddress, ledgerVersion ...uint64) (balances map[AccountAddress]uint64, errs []error)
+
+	// IsCoinRegistered checks whether the account has registered a CoinStore for the given legacy coin type
+	//
+	//	registered, err := client.IsCoinRegistered(address, coinType)
+	IsCoinRegistered(address AccountAddress, coinType TypeTag, ledgerVersion ...uint64) (bool, error)
+
 	// NodeAPIHealthCheck checks if the node is within durationSecs of the current time, if not provided the node default is used
 	NodeAPIHealthCheck(durationSecs ...uint64) (api.HealthCheckResponse, error)
 }
@@ -379,8 +541,9 @@ type AptosRpcClient interface {
 // AptosFaucetClient is an interface for all functionality on the Client that is Faucet related.  Its main implementation
 // is [FaucetClient]
 type AptosFaucetClient interface {
-	// Fund Uses the faucet to fund an address, only applies to non-production networks
-	Fund(address AccountAddress, amount uint64) error
+	// Fund Uses the faucet to fund an address, only applies to non-production networks.  Returns the hashes of the
+	// funding transactions.
+	Fund(address AccountAddress, amount uint64) ([]string, error)
 }
 
 // AptosIndexerClient is an interface for all functionality on the Client that is Indexer related.  Its main implementation
@@ -420,6 +583,16 @@ type AptosIndexerClient interface {
 
 	// GetCoinBalances gets the balances of all coins associated with a given address
 	GetCoinBalances(address AccountAddress) ([]CoinBalance, error)
+
+	// GetFungibleAssetBalances gets the balances of all fungible assets associated with a given address, including coins
+	GetFungibleAssetBalances(address AccountAddress) ([]FungibleAssetBalance, error)
+
+	// GetOwnedTokens gets all digital assets currently owned by a given address
+	GetOwnedTokens(address AccountAddress) ([]OwnedToken, error)
+
+	// GetAccountTransactionVersions gets the versions of transactions involving a given address, sent or received, from
+	// startVersion onward in ascending order
+	GetAccountTransactionVersions(address AccountAddress, startVersion uint64, limit uint64) ([]uint64, error)
 }
 
 // Client is a facade over the multiple types of underlying clients, as the user doesn't actually care where the data
@@ -510,16 +683,136 @@ func (client *Client) RemoveHeader(key string) {
 	client.nodeClient.RemoveHeader(key)
 }
 
+// WithLedgerCache enables caching of the gas estimate for the given TTL, which removes redundant requests when building
+// many transactions.  The chain ID is always cached after it is first fetched.  Returns the same client for chaining.
+//
+//	client.WithLedgerCache(5 * time.Second)
+func (client *Client) WithLedgerCache(ttl time.Duration) *Client {
+	client.nodeClient.WithLedgerCache(ttl)
+	return client
+}
+
+// WithModuleABICache enables caching of [Client.AccountModule] for the given TTL, which removes redundant requests
+// when repeatedly using the same module's ABI, e.g. with [Client.InspectRawTransaction].  Returns the same client for
+// chaining.
+//
+//	client.WithModuleABICache(10 * time.Minute)
+func (client *Client) WithModuleABICache(ttl time.Duration) *Client {
+	client.nodeClient.WithModuleABICache(ttl)
+	return client
+}
+
+// WithPreferBCS makes reads request the BCS representation from the node where it's supported, and decode it into the
+// same Go types as JSON, which is faster to parse.  This applies to [Client.Account], and the methods built on it, and
+// [Client.EstimateGasPrice].  Returns the same client for chaining.
+//
+//	client.WithPreferBCS(true)
+func (client *Client) WithPreferBCS(prefer bool) *Client {
+	client.nodeClient.WithPreferBCS(prefer)
+	return client
+}
+
+// WithUserAgent sets the User-Agent header on every node and faucet request, which lets node operators attribute
+// traffic to a service.  It defaults to [UserAgentHeaderValue].  Returns the same client for chaining.
+//
+//	client.WithUserAgent("my-service/1.2.0 " + aptos.UserAgentHeaderValue)
+func (client *Client) WithUserAgent(userAgent string) *Client {
+	client.nodeClient.WithUserAgent(userAgent)
+	return client
+}
+
+// WithResponseHook sets a hook that is called with the raw body of every node API response, which is useful for
+// debugging unexpected responses.  Returns the same client for chaining.
+//
+//	client.WithResponseHook(func(method string, status int, body []byte) {
+//		slog.Debug("response", "method", method, "status", status, "body", string(body))
+//	})
+func (client *Client) WithResponseHook(hook ResponseHook) *Client {
+	client.nodeClient.WithResponseHook(hook)
+	return client
+}
+
+// WithTransport sets the [http.Transport] used to send requests to the node and indexer, e.g. to tune connection
+// pooling under high concurrency.  Any SDK middleware such as [NewHedgedClient] is kept.  Returns the same client for
+// chaining.  See [NodeClient.WithTransport] for details.
+//
+//	client.WithTransport(&http.Transport{MaxIdleConnsPerHost: 100, ForceAttemptHTTP2: true})
+func (client *Client) WithTransport(transport *http.Transport) *Client {
+	client.nodeClient.WithTransport(transport)
+	return client
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections kept per host.  Returns the same client for
+// chaining.  See [NodeClient.WithMaxIdleConnsPerHost] for details.
+func (client *Client) WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) *Client {
+	client.nodeClient.WithMaxIdleConnsPerHost(maxIdleConnsPerHost)
+	return client
+}
+
+// WithForceHTTP2 sets whether HTTP/2 is attempted.  Returns the same client for chaining.  See
+// [NodeClient.WithForceHTTP2] for details.
+func (client *Client) WithForceHTTP2(forceHTTP2 bool) *Client {
+	client.nodeClient.WithForceHTTP2(forceHTTP2)
+	return client
+}
+
+// WithFaucet replaces the faucet used by [Client.Fund], e.g. to point at a local faucet in CI.  Options can be
+// [FaucetAuthToken] or [FaucetHeader].  Returns the same client for chaining.
+//
+//	client, err = client.WithFaucet("http://127.0.0.1:8081", FaucetAuthToken(os.Getenv("FAUCET_TOKEN")))
+func (client *Client) WithFaucet(faucetUrl string, options ...any) (*Client, error) {
+	faucetClient, err := NewFaucetClient(client.nodeClient, faucetUrl, options...)
+	if err != nil {
+		return nil, err
+	}
+	client.faucetClient = faucetClient
+	return client, nil
+}
+
 // Info Retrieves the node info about the network and it's current state
 func (client *Client) Info() (info NodeInfo, err error) {
 	return client.nodeClient.Info()
 }
 
+// LedgerInfo retrieves the node info with every field parsed, including the ledger timestamp as a time
+//
+//	info, err := client.LedgerInfo()
+//	expiration := info.LedgerTimestamp.Add(time.Minute)
+func (client *Client) LedgerInfo() (LedgerInfo, error) {
+	return client.nodeClient.LedgerInfo()
+}
+
+// VerifyNetwork checks that the node reports the expected chain ID, and returns a [*ChainMismatchError] if it
+// doesn't.  This is useful to call once on startup, to catch a client pointed at the wrong network.
+//
+//	err := client.VerifyNetwork(MainnetConfig.ChainId)
+//	if errors.Is(err, ErrChainMismatch) {
+//		// wrong node URL for the network
+//	}
+func (client *Client) VerifyNetwork(expectedChainId uint8) error {
+	return client.nodeClient.VerifyNetwork(expectedChainId)
+}
+
 // Account Retrieves information about the account such as [SequenceNumber] and [crypto.AuthenticationKey]
 func (client *Client) Account(address AccountAddress, ledgerVersion ...uint64) (info AccountInfo, err error) {
 	return client.nodeClient.Account(address, ledgerVersion...)
 }
 
+// AccountAuthKey retrieves the current [crypto.AuthenticationKey] of the account, which may differ from the address
+// if the key has been rotated.
+//
+// Optionally, a ledgerVersion can be given to get the authentication key at a specific ledger version
+func (client *Client) AccountAuthKey(address AccountAddress, ledgerVersion ...uint64) (*crypto.AuthenticationKey, error) {
+	return client.nodeClient.AccountAuthKey(address, ledgerVersion...)
+}
+
+// AccountScheme retrieves the [crypto.DeriveScheme] the account currently signs with, from the signature of the
+// last transaction sent by the account.  Errors if the account hasn't sent any transactions, or if that signature's
+// key doesn't match the on-chain authentication key.
+func (client *Client) AccountScheme(address AccountAddress) (crypto.DeriveScheme, error) {
+	return client.nodeClient.AccountScheme(address)
+}
+
 // AccountResource Retrieves a single resource given its struct name.
 //
 //	address := AccountOne
@@ -552,6 +845,38 @@ func (client *Client) AccountResourcesBCS(address AccountAddress, ledgerVersion
 	return client.nodeClient.AccountResourcesBCS(address, ledgerVersion...)
 }
 
+// AccountResourceGroup fetches all members of a resource group stored at an account into JSON-like maps keyed by
+// the member's type.
+// For fetching raw Move structs as BCS, See #AccountResourceGroupBCS
+//
+//	members, _ := client.AccountResourceGroup(objectAddress, "0x1::object::ObjectGroup")
+//	objectCore := members["0x1::object::ObjectCore"]
+func (client *Client) AccountResourceGroup(address AccountAddress, groupType string, ledgerVersion ...uint64) (members map[string]map[string]any, err error) {
+	return client.nodeClient.AccountResourceGroup(address, groupType, ledgerVersion...)
+}
+
+// AccountResourceGroupBCS fetches all members of a resource group stored at an account as raw Move struct BCS
+// blobs keyed by the member's type
+func (client *Client) AccountResourceGroupBCS(address AccountAddress, groupType string, ledgerVersion ...uint64) (members map[string][]byte, err error) {
+	return client.nodeClient.AccountResourceGroupBCS(address, groupType, ledgerVersion...)
+}
+
+// AccountModule fetches a single module's bytecode and ABI
+//
+//	module, _ := client.AccountModule(AccountOne, "coin")
+func (client *Client) AccountModule(address AccountAddress, moduleName string, ledgerVersion ...uint64) (data *api.MoveBytecode, err error) {
+	return client.nodeClient.AccountModule(address, moduleName, ledgerVersion...)
+}
+
+// InspectRawTransaction decodes a transaction into a human-readable summary, for review before signing.  This is
+// useful to verify a MultiAgent or FeePayer transaction built by another party before co-signing it.
+//
+//	summary, _ := client.InspectRawTransaction(rawTxn)
+//	fmt.Println(summary.String())
+func (client *Client) InspectRawTransaction(rawTxn *RawTransaction) (summary *TransactionSummary, err error) {
+	return client.nodeClient.InspectRawTransaction(rawTxn)
+}
+
 // BlockByHeight fetches a block by height
 //
 //	block, _ := client.BlockByHeight(1, false)
@@ -593,6 +918,16 @@ func (client *Client) TransactionByHash(txnHash string) (data *api.Transaction,
 	return client.nodeClient.TransactionByHash(txnHash)
 }
 
+// TransactionStatus gets the status of a transaction by hash, without waiting for it to be committed
+//
+//	status, err := client.TransactionStatus("0xabcd")
+//	if status == TxnStatusPending {
+//		// known to the mempool, but not committed yet
+//	}
+func (client *Client) TransactionStatus(txnHash string) (status TxnStatus, err error) {
+	return client.nodeClient.TransactionStatus(txnHash)
+}
+
 // TransactionByVersion gets info on a transaction from its LedgerVersion.  It must have been
 // committed to have a ledger version
 //
@@ -649,6 +984,61 @@ func (client *Client) AccountTransactions(address AccountAddress, start *uint64,
 	return client.nodeClient.AccountTransactions(address, start, limit)
 }
 
+// SubscribeAccountTransactions streams new transactions involving the account, until the context is cancelled.  The
+// node API doesn't support streaming, so this polls every PollPeriod, defaulting to 1 second.
+//
+// With an indexer configured, this includes transactions sent by other accounts e.g. incoming transfers, see
+// [NodeClient.SubscribeIndexedAccountTransactions].  Without one, it falls back to
+// [NodeClient.SubscribeAccountTransactions], and only transactions sent by the account are included.
+//
+//	txns, _ := client.SubscribeAccountTransactions(ctx, sender.AccountAddress(), PollPeriod(2*time.Second))
+//	for txn := range txns {
+//		if txn.Err != nil {
+//			// polling failed, it will be retried on the next period
+//			continue
+//		}
+//		fmt.Println(txn.Result.Hash())
+//	}
+func (client *Client) SubscribeAccountTransactions(ctx context.Context, address AccountAddress, options ...any) (txns <-chan ConcResponse[*api.CommittedTransaction], err error) {
+	if client.indexerClient != nil {
+		return client.nodeClient.SubscribeIndexedAccountTransactions(ctx, client.indexerClient, address, options...)
+	}
+	return client.nodeClient.SubscribeAccountTransactions(ctx, address, options...)
+}
+
+// StreamBlockTransactions streams every transaction in the blocks from startHeight to endHeight inclusive, in order.
+// If endHeight is 0, it follows the head of the chain, checking for new blocks every PollPeriod, defaulting to 1
+// second.
+//
+//	txns, _ := client.StreamBlockTransactions(ctx, 100, 200)
+//	for txn := range txns {
+//		if txn.Err != nil {
+//			// fetching failed, the block will be retried after the poll period
+//			continue
+//		}
+//		fmt.Println(txn.Result.Version())
+//	}
+func (client *Client) StreamBlockTransactions(ctx context.Context, startHeight uint64, endHeight uint64, options ...any) (txns <-chan ConcResponse[*api.CommittedTransaction], err error) {
+	return client.nodeClient.StreamBlockTransactions(ctx, startHeight, endHeight, options...)
+}
+
+// StreamAccountTransactions streams the transactions sent by the account, fetching a page at a time as they are
+// read.  Accepts options StreamStart, StreamLimit and StreamDescending.  Cancel the context to stop fetching early.
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	txns, _ := client.StreamAccountTransactions(ctx, address, StreamDescending(true), StreamLimit(500))
+//	for txn := range txns {
+//		if txn.Err != nil {
+//			// fetching failed, the channel is closed after the error
+//			break
+//		}
+//		fmt.Println(txn.Result.Version())
+//	}
+func (client *Client) StreamAccountTransactions(ctx context.Context, address AccountAddress, options ...any) (txns <-chan ConcResponse[*api.CommittedTransaction], err error) {
+	return client.nodeClient.StreamAccountTransactions(ctx, address, options...)
+}
+
 // SubmitTransaction Submits an already signed transaction to the blockchain
 //
 //	sender := NewEd25519Account()
@@ -673,6 +1063,29 @@ func (client *Client) SubmitTransaction(signedTransaction *SignedTransaction) (d
 	return client.nodeClient.SubmitTransaction(signedTransaction)
 }
 
+// SubmitTransactionBCS submits an already BCS serialized signed transaction to the blockchain, without decoding it.
+// This is useful for relaying transactions signed elsewhere, such as by a signing server.
+//
+//	signedTxnBytes, _ := signingServer.Sign(rawTxn)
+//	submitResponse, err := client.SubmitTransactionBCS(signedTxnBytes)
+func (client *Client) SubmitTransactionBCS(signedTxnBytes []byte) (data *api.SubmitTransactionResponse, err error) {
+	return client.nodeClient.SubmitTransactionBCS(signedTxnBytes)
+}
+
+// SubmitTransactionIdempotent submits a signed transaction, unless the node already knows of it, so it is safe to
+// retry.  Instead of failing with a sequence number error, it returns the status of the existing transaction.
+//
+//	hash, status, err := client.SubmitTransactionIdempotent(signedTxn)
+//	if err != nil {
+//		return err
+//	}
+//	if status == TxnStatusPending {
+//		userTxn, err := client.WaitForTransaction(hash)
+//	}
+func (client *Client) SubmitTransactionIdempotent(signedTxn *SignedTransaction) (hash string, status TxnStatus, err error) {
+	return client.nodeClient.SubmitTransactionIdempotent(signedTxn)
+}
+
 // BatchSubmitTransaction submits a collection of signed transactions to the network in a single request
 //
 // It will return the responses in the same order as the input transactions that failed.  If the response is empty, then
@@ -719,18 +1132,34 @@ func (client *Client) BatchSubmitTransaction(signedTxns []*SignedTransaction) (r
 //	}
 //	rawTxn, _ := client.BuildTransaction(sender.AccountAddress(), txnPayload)
 //	simResponse, err := client.SimulateTransaction(rawTxn, sender)
+//
+// To check whether the transaction succeeds with a specific max gas amount
+//
+//	simResponse, err := client.SimulateTransaction(rawTxn, sender, SimulateMaxGas(2000))
 func (client *Client) SimulateTransaction(rawTxn *RawTransaction, sender TransactionSigner, options ...any) (data []*api.UserTransaction, err error) {
 	return client.nodeClient.SimulateTransaction(rawTxn, sender, options...)
 }
 
+// SimulateFeePayerTransaction simulates a MultiAgent or FeePayer transaction using only the public keys of the parties
+//
+//	rawTxn, _ := client.BuildTransactionMultiAgent(alice.AccountAddress(), txnPayload, FeePayer(&sponsor.Address))
+//	simResponse, err := client.SimulateFeePayerTransaction(rawTxn, alice.PubKey(), sponsor.PubKey())
+func (client *Client) SimulateFeePayerTransaction(rawTxn *RawTransactionWithData, senderPubKey crypto.PublicKey, feePayerPubKey crypto.PublicKey, secondarySigners ...crypto.PublicKey) (data *api.UserTransaction, err error) {
+	return client.nodeClient.SimulateFeePayerTransaction(rawTxn, senderPubKey, feePayerPubKey, secondarySigners...)
+}
+
 // GetChainId Retrieves the ChainId of the network
 // Note this will be cached forever, or taken directly from the config
 func (client *Client) GetChainId() (chainId uint8, err error) {
 	return client.nodeClient.GetChainId()
 }
 
-// Fund Uses the faucet to fund an address, only applies to non-production networks
-func (client *Client) Fund(address AccountAddress, amount uint64) error {
+// Fund Uses the faucet to fund an address, only applies to non-production networks.  Returns the hashes of the
+// funding transactions.
+func (client *Client) Fund(address AccountAddress, amount uint64) ([]string, error) {
+	if client.faucetClient == nil {
+		return nil, errors.New("faucet not configured for this client")
+	}
 	return client.faucetClient.Fund(address, amount)
 }
 
@@ -756,6 +1185,15 @@ func (client *Client) BuildTransaction(sender AccountAddress, payload Transactio
 	return client.nodeClient.BuildTransaction(sender, payload, options...)
 }
 
+// SequenceManagerFor returns the shared [SequenceManager] for the account, which hands out sequence numbers locally
+// to build many transactions quickly, and resynchronizes with the node on sequence number errors
+//
+//	sequenceNumber, err := client.SequenceManagerFor(sender.AccountAddress()).Next()
+//	rawTxn, err := client.BuildTransaction(sender.AccountAddress(), txnPayload, SequenceNumber(sequenceNumber))
+func (client *Client) SequenceManagerFor(address AccountAddress) *SequenceManager {
+	return client.nodeClient.SequenceManagerFor(address)
+}
+
 // BuildTransactionMultiAgent Builds a raw transaction for MultiAgent or FeePayer from the payload and fetches any necessary information from on-chain
 //
 //	sender := NewEd25519Account()
@@ -819,6 +1257,16 @@ func (client *Client) View(payload *ViewPayload, ledgerVersion ...uint64) (vals
 	return client.nodeClient.View(payload, ledgerVersion...)
 }
 
+// ViewBatch runs multiple view functions concurrently, returning values and errors in the same order as the payloads.
+//
+//	vals, errs := client.ViewBatch(ctx, []*ViewPayload{payload1, payload2})
+//	if errs[0] == nil {
+//		balance := StrToU64(vals[0][0].(string))
+//	}
+func (client *Client) ViewBatch(ctx context.Context, payloads []*ViewPayload, ledgerVersion ...uint64) (vals [][]any, errs []error) {
+	return client.nodeClient.ViewBatch(ctx, payloads, ledgerVersion...)
+}
+
 // EstimateGasPrice Retrieves the gas estimate from the network.
 func (client *Client) EstimateGasPrice() (info EstimateGasInfo, err error) {
 	return client.nodeClient.EstimateGasPrice()
@@ -829,6 +1277,22 @@ func (client *Client) AccountAPTBalance(address AccountAddress, ledgerVersion ..
 	return client.nodeClient.AccountAPTBalance(address, ledgerVersion...)
 }
 
+// AccountBalances fetches the APT balances of many accounts concurrently, in octas.  The errors are in the same
+// order as the accounts, and an account without any APT, including one that doesn't exist, has a balance of 0 rather
+// than an error.
+//
+//	balances, errs := client.AccountBalances(ctx, addresses)
+func (client *Client) AccountBalances(ctx context.Context, accounts []AccountAddress, ledgerVersion ...uint64) (balances map[AccountAddress]uint64, errs []error) {
+	return client.nodeClient.AccountBalances(ctx, accounts, ledgerVersion...)
+}
+
+// IsCoinRegistered checks whether the account has registered a CoinStore for the given legacy coin type
+//
+//	registered, err := client.IsCoinRegistered(address, coinType)
+func (client *Client) IsCoinRegistered(address AccountAddress, coinType TypeTag, ledgerVersion ...uint64) (bool, error) {
+	return client.nodeClient.IsCoinRegistered(address, coinType, ledgerVersion...)
+}
+
 // QueryIndexer queries the indexer using GraphQL to fill the `query` struct with data.  See examples in the indexer client on how to make queries
 //
 //	var out []CoinBalance
@@ -869,6 +1333,22 @@ func (client *Client) GetCoinBalances(address AccountAddress) ([]CoinBalance, er
 	return client.indexerClient.GetCoinBalances(address)
 }
 
+// GetFungibleAssetBalances gets the balances of all fungible assets associated with a given address, including coins
+func (client *Client) GetFungibleAssetBalances(address AccountAddress) ([]FungibleAssetBalance, error) {
+	return client.indexerClient.GetFungibleAssetBalances(address)
+}
+
+// GetOwnedTokens gets all digital assets currently owned by a given address
+func (client *Client) GetOwnedTokens(address AccountAddress) ([]OwnedToken, error) {
+	return client.indexerClient.GetOwnedTokens(address)
+}
+
+// GetAccountTransactionVersions gets the versions of transactions involving a given address, sent or received, from
+// startVersion onward in ascending order
+func (client *Client) GetAccountTransactionVersions(address AccountAddress, startVersion uint64, limit uint64) ([]uint64, error) {
+	return client.indexerClient.GetAccountTransactionVersions(address, startVersion, limit)
+}
+
 // NodeAPIHealthCheck checks if the node is within durationSecs of the current time, if not provided the node default is used
 func (client *Client) NodeAPIHealthCheck(durationSecs ...uint64) (api.HealthCheckResponse, error) {
 	return client.nodeClient.NodeHealthCheck(durationSecs...)
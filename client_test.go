@@ -117,7 +117,7 @@ func setupIntegrationTest(t *testing.T, createAccount CreateSigner) (*Client, Tr
 	assert.NoError(t, err)
 
 	// Fund the account with 1 APT
-	err = client.Fund(account.AccountAddress(), fundAmount)
+	_, err = client.Fund(account.AccountAddress(), fundAmount)
 	assert.NoError(t, err)
 
 	return client, account
@@ -241,7 +241,7 @@ func Test_Indexer(t *testing.T) {
 	account, err := NewEd25519Account()
 	assert.NoError(t, err)
 
-	err = client.Fund(account.AccountAddress(), 10)
+	_, err = client.Fund(account.AccountAddress(), 10)
 	assert.NoError(t, err)
 
 	balance, err := client.AccountAPTBalance(account.AccountAddress())
@@ -381,7 +381,7 @@ func Test_AccountTransactions(t *testing.T) {
 	// Create a bunch of transactions so we can test the pagination
 	account, err := NewEd25519Account()
 	assert.NoError(t, err)
-	err = client.Fund(account.AccountAddress(), 100_000_000)
+	_, err = client.Fund(account.AccountAddress(), 100_000_000)
 	assert.NoError(t, err)
 
 	// Build and submit 100 transactions
@@ -544,9 +544,9 @@ func Test_Concurrent_Submission(t *testing.T) {
 	account2, err := NewEd25519Account()
 	assert.NoError(t, err)
 
-	err = client.Fund(account1.AccountAddress(), 100_000_000)
+	_, err = client.Fund(account1.AccountAddress(), 100_000_000)
 	assert.NoError(t, err)
-	err = client.Fund(account2.AccountAddress(), 0)
+	_, err = client.Fund(account2.AccountAddress(), 0)
 	assert.NoError(t, err)
 
 	// start submission goroutine
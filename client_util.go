@@ -1,6 +1,7 @@
 package aptos
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"runtime/debug"
@@ -12,16 +13,29 @@ const ClientHeader = "x-aptos-client"
 // ClientHeaderValue is the header value for the SDK version
 var ClientHeaderValue = "aptos-go-sdk/unk"
 
-// Sets up the ClientHeaderValue with the SDK version
+// UserAgentHeaderValue is the default User-Agent header, the SDK and its version.  It can be changed per client with
+// [NodeClient.WithUserAgent]
+var UserAgentHeaderValue = "aptos-go-sdk/unk"
+
+// sdkModulePath is the module path of the SDK, used to find its version when it is a dependency
+const sdkModulePath = "github.com/aptos-labs/aptos-go-sdk"
+
+// Sets up the ClientHeaderValue and UserAgentHeaderValue with the SDK version
 func init() {
 	vcsRevision := "unk"
 	vcsMod := ""
 	goArch := ""
 	goOs := ""
 	params := url.Values{}
+	sdkVersion := ""
 	buildInfo, ok := debug.ReadBuildInfo()
 	if ok {
 		params.Set("go", buildInfo.GoVersion)
+		for _, dep := range buildInfo.Deps {
+			if dep.Path == sdkModulePath {
+				sdkVersion = dep.Version
+			}
+		}
 		for _, setting := range buildInfo.Settings {
 			switch setting.Key {
 			case "vcs.revision":
@@ -46,12 +60,16 @@ func init() {
 		params.Set("os", goOs)
 	}
 	ClientHeaderValue = fmt.Sprintf("aptos-go-sdk/%s;%s", vcsRevision, params.Encode())
+	if sdkVersion == "" {
+		sdkVersion = vcsRevision
+	}
+	UserAgentHeaderValue = fmt.Sprintf("aptos-go-sdk/%s", sdkVersion)
 }
 
 // APTTransferTransaction Move some APT from sender to dest, only for single signer
 // Amount in Octas (10^-8 APT)
 //
-// options may be: MaxGasAmount, GasUnitPrice, ExpirationSeconds, ValidUntil, SequenceNumber, ChainIdOption
+// options may be: MaxGasAmount, GasUnitPrice, ExpirationSeconds, ExpireAfter, ExpireAt, SequenceNumber, ChainIdOption
 // deprecated, please use the EntryFunction APIs
 func APTTransferTransaction(client *Client, sender TransactionSigner, dest AccountAddress, amount uint64, options ...any) (rawTxn *RawTransaction, err error) {
 	entryFunction, err := CoinTransferPayload(nil, dest, amount)
@@ -63,3 +81,37 @@ func APTTransferTransaction(client *Client, sender TransactionSigner, dest Accou
 		TransactionPayload{Payload: entryFunction}, options...)
 	return
 }
+
+// AccountResourceInto fetches a single resource given its struct name, and decodes its data into T with JSON.
+//
+// This removes the need to cast the map[string]any returned by [Client.AccountResource].  Note that u64, u128, and u256
+// values are represented as strings in JSON.
+//
+//	type CoinStore struct {
+//		Coin struct {
+//			Value string `json:"value"`
+//		} `json:"coin"`
+//		Frozen bool `json:"frozen"`
+//	}
+//	coinStore, err := AccountResourceInto[CoinStore](client, AccountOne, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>")
+func AccountResourceInto[T any](client AptosRpcClient, address AccountAddress, resourceType string, ledgerVersion ...uint64) (*T, error) {
+	resource, err := client.AccountResource(address, resourceType, ledgerVersion...)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := resource["data"]
+	if !ok {
+		return nil, fmt.Errorf("resource %s has no data", resourceType)
+	}
+	// Round trip through JSON, so any struct tags on T are respected
+	blob, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	out := new(T)
+	err = json.Unmarshal(blob, out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode resource %s: %w", resourceType, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,40 @@
+package aptos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// resourceTestClient overrides AccountResource, any other call will panic
+type resourceTestClient struct {
+	AptosRpcClient
+	resource map[string]any
+}
+
+func (c *resourceTestClient) AccountResource(_ AccountAddress, _ string, _ ...uint64) (map[string]any, error) {
+	return c.resource, nil
+}
+
+func TestAccountResourceInto(t *testing.T) {
+	type multisigAccount struct {
+		NumSignaturesRequired string           `json:"num_signatures_required"`
+		Owners                []AccountAddress `json:"owners"`
+	}
+
+	client := &resourceTestClient{resource: map[string]any{
+		"type": "0x1::multisig_account::MultisigAccount",
+		"data": map[string]any{
+			"num_signatures_required": "2",
+			"owners":                  []any{"0x1", "0x2"},
+		},
+	}}
+	resource, err := AccountResourceInto[multisigAccount](client, AccountOne, "0x1::multisig_account::MultisigAccount")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", resource.NumSignaturesRequired)
+	assert.Equal(t, []AccountAddress{AccountOne, AccountTwo}, resource.Owners)
+
+	client.resource = map[string]any{"type": "0x1::multisig_account::MultisigAccount"}
+	_, err = AccountResourceInto[multisigAccount](client, AccountOne, "0x1::multisig_account::MultisigAccount")
+	assert.Error(t, err)
+}
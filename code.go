@@ -1,6 +1,10 @@
 package aptos
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 )
 
@@ -17,9 +21,7 @@ func PublishPackagePayloadFromJsonFile(metadata []byte, bytecode [][]byte) (*Tra
 		return nil, err
 	}
 
-	bytecodeBytes, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
-		bcs.SerializeSequenceWithFunction(bytecode, ser, (*bcs.Serializer).WriteBytes)
-	})
+	bytecodeBytes, err := bcs.SerializeSequenceOf(bytecode, (*bcs.Serializer).WriteBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -34,3 +36,57 @@ func PublishPackagePayloadFromJsonFile(metadata []byte, bytecode [][]byte) (*Tra
 		Args:     [][]byte{metadataBytes, bytecodeBytes},
 	}}, nil
 }
+
+// PublishPackagePayloadFromDir publishes a package compiled with the Aptos CLI, reading the compiled artifacts directly
+// from the build output directory.  The Aptos CLI generates the directory with the following CLI command:
+//
+//	aptos move compile --save-metadata
+//
+// dir must be the package's build directory e.g. build/MyPackage, which contains package-metadata.bcs and the
+// bytecode_modules directory.  The modules are published in the order listed in the package metadata, which is the
+// order out of the compiler.
+//
+//	payload, err := PublishPackagePayloadFromDir("build/MyPackage")
+func PublishPackagePayloadFromDir(dir string) (*TransactionPayload, error) {
+	metadata, err := os.ReadFile(filepath.Join(dir, "package-metadata.bcs"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package metadata: %w", err)
+	}
+	moduleNames, err := packageModuleNames(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	bytecode := make([][]byte, len(moduleNames))
+	for i, name := range moduleNames {
+		bytecode[i], err = os.ReadFile(filepath.Join(dir, "bytecode_modules", name+".mv"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bytecode for module %s: %w", name, err)
+		}
+	}
+	return PublishPackagePayloadFromJsonFile(metadata, bytecode)
+}
+
+// packageModuleNames reads the module names, in order, from BCS encoded `0x1::code::PackageMetadata`
+func packageModuleNames(metadata []byte) ([]string, error) {
+	des := bcs.NewDeserializer(metadata)
+	des.ReadString() // name
+	des.U8()         // upgrade_policy
+	des.U64()        // upgrade_number
+	des.ReadString() // source_digest
+	des.ReadBytes()  // manifest
+	names := bcs.DeserializeSequenceWithFunction(des, func(des *bcs.Deserializer, name *string) {
+		*name = des.ReadString()
+		des.ReadBytes() // source
+		des.ReadBytes() // source_map
+		// extension: Option<Any>
+		bcs.DeserializeOption(des, func(des *bcs.Deserializer, _ *[]byte) {
+			des.ReadString() // type_name
+			des.ReadBytes()  // data
+		})
+	})
+	if des.Error() != nil {
+		return nil, fmt.Errorf("failed to deserialize package metadata: %w", des.Error())
+	}
+	return names, nil
+}
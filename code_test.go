@@ -1 +1,57 @@
 package aptos
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishPackagePayloadFromDir(t *testing.T) {
+	// Package metadata, with the modules listed out of alphabetical order
+	metadata, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.WriteString("MyPackage")
+		ser.U8(1)
+		ser.U64(0)
+		ser.WriteString("ABCDEF")
+		ser.WriteBytes([]byte("manifest"))
+		ser.Uleb128(2)
+		for _, name := range []string{"token", "helpers"} {
+			ser.WriteString(name)
+			ser.WriteBytes([]byte{})
+			ser.WriteBytes([]byte{})
+			ser.Uleb128(0) // No extension
+		}
+		ser.Uleb128(0) // No deps
+		ser.Uleb128(0) // No extension
+	})
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "bytecode_modules"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "package-metadata.bcs"), metadata, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "bytecode_modules", "helpers.mv"), []byte{0xa1, 0x1c}, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "bytecode_modules", "token.mv"), []byte{0xa1, 0x1c, 0xeb}, 0o644))
+
+	payload, err := PublishPackagePayloadFromDir(dir)
+	assert.NoError(t, err)
+	expected, err := PublishPackagePayloadFromJsonFile(metadata, [][]byte{{0xa1, 0x1c, 0xeb}, {0xa1, 0x1c}})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, payload)
+
+	// A missing module is an error
+	assert.NoError(t, os.Remove(filepath.Join(dir, "bytecode_modules", "helpers.mv")))
+	_, err = PublishPackagePayloadFromDir(dir)
+	assert.ErrorContains(t, err, "helpers")
+
+	// Invalid metadata is an error
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "package-metadata.bcs"), []byte{0x01}, 0o644))
+	_, err = PublishPackagePayloadFromDir(dir)
+	assert.Error(t, err)
+
+	// A missing directory is an error
+	_, err = PublishPackagePayloadFromDir(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
@@ -14,7 +14,7 @@ func CoinTransferPayload(coinType *TypeTag, dest AccountAddress, amount uint64)
 		return nil, err
 	}
 
-	if coinType == nil || *coinType == AptosCoinTypeTag {
+	if coinType == nil || coinType.Equals(AptosCoinTypeTag) {
 		return &EntryFunction{
 			Module: ModuleId{
 				Address: AccountOne,
@@ -54,16 +54,12 @@ func CoinBatchTransferPayload(coinType *TypeTag, dests []AccountAddress, amounts
 	if err != nil {
 		return nil, err
 	}
-	amountsBytes, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
-		bcs.SerializeSequenceWithFunction(amounts, ser, func(ser *bcs.Serializer, amount uint64) {
-			ser.U64(amount)
-		})
-	})
+	amountsBytes, err := bcs.SerializeSequenceOf(amounts, (*bcs.Serializer).U64)
 	if err != nil {
 		return nil, err
 	}
 
-	if coinType == nil || *coinType == AptosCoinTypeTag {
+	if coinType == nil || coinType.Equals(AptosCoinTypeTag) {
 		return &EntryFunction{
 			Module: ModuleId{
 				Address: AccountOne,
@@ -91,3 +87,21 @@ func CoinBatchTransferPayload(coinType *TypeTag, dests []AccountAddress, amounts
 		}, nil
 	}
 }
+
+// RegisterCoinPayload builds an EntryFunction payload for registering a CoinStore for a legacy coin
+//
+// An account must register a coin before it can receive it, this can be checked with [Client.IsCoinRegistered].
+//
+// Args:
+//   - coinType is the type of coin to register e.g. 0x1::aptos_coin::AptosCoin
+func RegisterCoinPayload(coinType TypeTag) (payload *EntryFunction) {
+	return &EntryFunction{
+		Module: ModuleId{
+			Address: AccountOne,
+			Name:    "managed_coin",
+		},
+		Function: "register",
+		ArgTypes: []TypeTag{coinType},
+		Args:     [][]byte{},
+	}
+}
@@ -16,6 +16,7 @@ import (
 //   - [SingleKeyScheme]
 //   - [MultiKeyScheme]
 //   - [DeriveObjectScheme]
+//   - [ObjectFromGuidScheme]
 //   - [NamedObjectScheme]
 //   - [ResourceAccountScheme]
 type DeriveScheme = uint8
@@ -27,6 +28,7 @@ const (
 	SingleKeyScheme       DeriveScheme = 2   // SingleKeyScheme is the scheme for deriving the AuthenticationKey for single-key accounts
 	MultiKeyScheme        DeriveScheme = 3   // MultiKeyScheme is the scheme for deriving the AuthenticationKey for multi-key accounts
 	DeriveObjectScheme    DeriveScheme = 252 // DeriveObjectScheme is the scheme for deriving the AuthenticationKey for objects, used to create new object addresses
+	ObjectFromGuidScheme  DeriveScheme = 253 // ObjectFromGuidScheme is the scheme for deriving the AuthenticationKey for objects created from an account GUID
 	NamedObjectScheme     DeriveScheme = 254 // NamedObjectScheme is the scheme for deriving the AuthenticationKey for named objects, used to create new named object addresses
 	ResourceAccountScheme DeriveScheme = 255 // ResourceAccountScheme is the scheme for deriving the AuthenticationKey for resource accounts, used to create new resource account addresses
 )
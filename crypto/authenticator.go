@@ -1,9 +1,11 @@
 package crypto
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/aptos-labs/aptos-go-sdk/internal/util"
 )
 
 // AccountAuthenticatorImpl an implementation of an authenticator to provide generic verification across multiple types.
@@ -47,6 +49,8 @@ const (
 //   - [bcs.Marshaler]
 //   - [bcs.Unmarshaler]
 //   - [bcs.Struct]
+//   - [json.Marshaler]
+//   - [json.Unmarshaler]
 type AccountAuthenticator struct {
 	Variant AccountAuthenticatorType // Variant is the type of authenticator
 	Auth    AccountAuthenticatorImpl // Auth is the actual authenticator
@@ -64,8 +68,13 @@ func (ea *AccountAuthenticator) Signature() Signature {
 	return ea.Auth.Signature()
 }
 
-// Verify returns true if the authenticator can be cryptographically verified
+// Verify returns true if the authenticator's signature is valid for the message with its public key, for every
+// variant.  For a transaction, the message is the signing message from RawTransaction.SigningMessage, which lets
+// a relayer check a sender's signature before submitting it.
 func (ea *AccountAuthenticator) Verify(data []byte) bool {
+	if ea.Auth == nil {
+		return false
+	}
 	return ea.Auth.Verify(data)
 }
 
@@ -108,6 +117,102 @@ func (ea *AccountAuthenticator) UnmarshalBCS(des *bcs.Deserializer) {
 	ea.Auth.UnmarshalBCS(des)
 }
 
+//endregion
+
+//region AccountAuthenticator JSON
+
+// accountAuthenticatorJSON is the JSON representation of an [AccountAuthenticator], the public key and signature are the
+// hex encoded BCS bytes, so that the authenticator round trips exactly e.g.
+//
+//	{
+//	  "type": "ed25519",
+//	  "public_key": "0x20...",
+//	  "signature": "0x40..."
+//	}
+type accountAuthenticatorJSON struct {
+	Type      string `json:"type"`
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+var accountAuthenticatorJSONTypes = map[AccountAuthenticatorType]string{
+	AccountAuthenticatorEd25519:      "ed25519",
+	AccountAuthenticatorMultiEd25519: "multi_ed25519",
+	AccountAuthenticatorSingleSender: "single_key",
+	AccountAuthenticatorMultiKey:     "multi_key",
+}
+
+// MarshalJSON serializes the [AccountAuthenticator] to JSON, for sending between parties e.g. a sender and a sponsor
+//
+// Implements:
+//   - [json.Marshaler]
+func (ea *AccountAuthenticator) MarshalJSON() ([]byte, error) {
+	typeName, ok := accountAuthenticatorJSONTypes[ea.Variant]
+	if !ok {
+		return nil, fmt.Errorf("unknown AccountAuthenticator kind: %d", ea.Variant)
+	}
+	pubKeyBytes, err := bcs.Serialize(ea.Auth.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	sigBytes, err := bcs.Serialize(ea.Auth.Signature())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&accountAuthenticatorJSON{
+		Type:      typeName,
+		PublicKey: util.BytesToHex(pubKeyBytes),
+		Signature: util.BytesToHex(sigBytes),
+	})
+}
+
+// UnmarshalJSON deserializes the [AccountAuthenticator] from JSON
+//
+// Implements:
+//   - [json.Unmarshaler]
+func (ea *AccountAuthenticator) UnmarshalJSON(b []byte) error {
+	data := &accountAuthenticatorJSON{}
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return err
+	}
+	variant, found := AccountAuthenticatorType(0), false
+	for v, typeName := range accountAuthenticatorJSONTypes {
+		if typeName == data.Type {
+			variant, found = v, true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("unknown AccountAuthenticator type: %s", data.Type)
+	}
+	pubKeyBytes, err := util.ParseHex(data.PublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid AccountAuthenticator public key: %w", err)
+	}
+	sigBytes, err := util.ParseHex(data.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid AccountAuthenticator signature: %w", err)
+	}
+
+	// The BCS form is the variant, followed by the public key and the signature
+	ser := &bcs.Serializer{}
+	ser.Uleb128(uint32(variant))
+	ser.FixedBytes(pubKeyBytes)
+	ser.FixedBytes(sigBytes)
+	des := bcs.NewDeserializer(ser.ToBytes())
+	ea.UnmarshalBCS(des)
+	if des.Error() != nil {
+		return des.Error()
+	}
+	if des.Remaining() != 0 {
+		return fmt.Errorf("invalid AccountAuthenticator, %d trailing bytes", des.Remaining())
+	}
+	return nil
+}
+
+//endregion
+
 func (ea *AccountAuthenticator) FromKeyAndSignature(key PublicKey, sig Signature) error {
 	switch key.(type) {
 	case *Ed25519PublicKey:
@@ -160,5 +265,42 @@ func (ea *AccountAuthenticator) FromKeyAndSignature(key PublicKey, sig Signature
 	return nil
 }
 
-//endregion
+// SimulationAuthenticatorFromPublicKey creates an [AccountAuthenticator] with an empty signature for the given [PublicKey]
+//
+// This is useful for simulating transactions where only the public key of a party is known, such as a fee payer or
+// secondary signer on another machine.  MultiEd25519 and MultiKey are not currently supported for simulation.
+func SimulationAuthenticatorFromPublicKey(key PublicKey) (*AccountAuthenticator, error) {
+	switch key := key.(type) {
+	case *Ed25519PublicKey:
+		return &AccountAuthenticator{
+			Variant: AccountAuthenticatorEd25519,
+			Auth: &Ed25519Authenticator{
+				PubKey: key,
+				Sig:    &Ed25519Signature{},
+			},
+		}, nil
+	case *AnyPublicKey:
+		sig := &AnySignature{}
+		switch key.Variant {
+		case AnyPublicKeyVariantEd25519:
+			sig.Variant = AnySignatureVariantEd25519
+			sig.Signature = &Ed25519Signature{}
+		case AnyPublicKeyVariantSecp256k1:
+			sig.Variant = AnySignatureVariantSecp256k1
+			sig.Signature = (&Secp256k1PrivateKey{}).EmptySignature()
+		default:
+			return nil, fmt.Errorf("unsupported AnyPublicKey variant for simulation %d", key.Variant)
+		}
+		return &AccountAuthenticator{
+			Variant: AccountAuthenticatorSingleSender,
+			Auth: &SingleKeyAuthenticator{
+				PubKey: key,
+				Sig:    sig,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type for simulation %T", key)
+	}
+}
+
 //endregion
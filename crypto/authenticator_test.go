@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/ed25519"
+	"encoding/json"
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/aptos-labs/aptos-go-sdk/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -79,6 +80,36 @@ func Test_AuthenticatorVerification(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.True(t, authenticator.Verify(msg))
+
+	// Every variant verifies against the message it signed, and only that message
+	otherMsg := []byte{0x01, 0x03}
+	secpKey, err := GenerateSecp256k1Key()
+	assert.NoError(t, err)
+	singleKeyAuthenticator, err := NewSingleSigner(secpKey).Sign(msg)
+	assert.NoError(t, err)
+
+	multiEd25519Key1, multiEd25519Key2, _, _, multiEd25519PublicKey := createMultiEd25519Key(t)
+	multiEd25519Authenticator := &AccountAuthenticator{}
+	assert.NoError(t, multiEd25519Authenticator.FromKeyAndSignature(multiEd25519PublicKey, createMultiEd25519Signature(t, multiEd25519Key1, multiEd25519Key2, msg)))
+
+	multiKey1, multiKey2, _, _, _, _, multiKeyPublicKey := createMultiKey(t)
+	multiKeyAuthenticator := &AccountAuthenticator{}
+	assert.NoError(t, multiKeyAuthenticator.FromKeyAndSignature(multiKeyPublicKey, createMultiKeySignature(t, 0, multiKey1, 1, multiKey2, msg)))
+
+	for _, auth := range []*AccountAuthenticator{authenticator, singleKeyAuthenticator, multiEd25519Authenticator, multiKeyAuthenticator} {
+		assert.True(t, auth.Verify(msg), auth.Variant)
+		assert.False(t, auth.Verify(otherMsg), auth.Variant)
+	}
+
+	// MultiKey signatures must be checked against the keys in the bitmap
+	swapped := &AccountAuthenticator{}
+	assert.NoError(t, swapped.FromKeyAndSignature(multiKeyPublicKey, createMultiKeySignature(t, 0, multiKey2, 1, multiKey1, msg)))
+	assert.False(t, swapped.Verify(msg))
+	missingSignature := createMultiKeySignature(t, 0, multiKey1, 1, multiKey2, msg)
+	missingSignature.Signatures = missingSignature.Signatures[:1]
+	assert.False(t, multiKeyPublicKey.Verify(msg, missingSignature))
+
+	assert.False(t, (&AccountAuthenticator{}).Verify(msg))
 }
 
 func Test_InvalidAuthenticatorDeserialization(t *testing.T) {
@@ -98,3 +129,61 @@ func Test_InvalidAuthenticationKeyDeserialization(t *testing.T) {
 	err := bcs.Deserialize(&newAuthkey, serialized)
 	assert.Error(t, err)
 }
+
+func Test_SimulationAuthenticatorFromPublicKey(t *testing.T) {
+	ed25519Key, err := GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	secp256k1Key, err := GenerateSecp256k1Key()
+	assert.NoError(t, err)
+
+	signers := []Signer{
+		ed25519Key,
+		NewSingleSigner(ed25519Key),
+		NewSingleSigner(secp256k1Key),
+	}
+	for _, signer := range signers {
+		auth, err := SimulationAuthenticatorFromPublicKey(signer.PubKey())
+		assert.NoError(t, err)
+		assert.Equal(t, signer.SimulationAuthenticator(), auth)
+	}
+
+	_, err = SimulationAuthenticatorFromPublicKey(&MultiKey{})
+	assert.Error(t, err)
+}
+
+func Test_AuthenticatorJSON(t *testing.T) {
+	ed25519Key, err := GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	secp256k1Key, err := GenerateSecp256k1Key()
+	assert.NoError(t, err)
+
+	message := []byte("hello world")
+	signers := []Signer{
+		ed25519Key,
+		NewSingleSigner(ed25519Key),
+		NewSingleSigner(secp256k1Key),
+	}
+	for _, signer := range signers {
+		auth, err := signer.Sign(message)
+		assert.NoError(t, err)
+
+		jsonBytes, err := json.Marshal(auth)
+		assert.NoError(t, err)
+
+		decoded := &AccountAuthenticator{}
+		err = json.Unmarshal(jsonBytes, decoded)
+		assert.NoError(t, err)
+
+		// Round trip must preserve the exact BCS bytes
+		expected, err := bcs.Serialize(auth)
+		assert.NoError(t, err)
+		actual, err := bcs.Serialize(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+		assert.True(t, decoded.Verify(message))
+	}
+
+	auth := &AccountAuthenticator{}
+	assert.Error(t, json.Unmarshal([]byte(`{"type":"unknown","public_key":"0x","signature":"0x"}`), auth))
+	assert.Error(t, json.Unmarshal([]byte(`{"type":"ed25519","public_key":"0x00","signature":"0x00"}`), auth))
+}
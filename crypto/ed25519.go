@@ -24,6 +24,8 @@ import (
 //   - [bcs.Struct]
 type Ed25519PrivateKey struct {
 	Inner ed25519.PrivateKey // Inner is the actual private key
+
+	zeroizedPubKey ed25519.PublicKey // zeroizedPubKey is the public key kept after [Ed25519PrivateKey.Zeroize]
 }
 
 // GenerateEd25519PrivateKey generates a random [Ed25519PrivateKey]
@@ -42,20 +44,22 @@ func GenerateEd25519PrivateKey(rand ...io.Reader) (privateKey *Ed25519PrivateKey
 	if err != nil {
 		return nil, err
 	}
-	return &Ed25519PrivateKey{priv}, nil
+	return &Ed25519PrivateKey{Inner: priv}, nil
 }
 
 //region Ed25519PrivateKey Signer Implementation
 
 // Sign signs a message and returns an [AccountAuthenticator] with the [Ed25519Signature] and [Ed25519PublicKey]
 //
-// Never returns an error.
+// Returns [ErrKeyZeroized] if the key has been zeroized, otherwise never returns an error.
 //
 // Implements:
 //   - [Signer]
 func (key *Ed25519PrivateKey) Sign(msg []byte) (authenticator *AccountAuthenticator, err error) {
-	// Can't error
-	signature, _ := key.SignMessage(msg)
+	signature, err := key.SignMessage(msg)
+	if err != nil {
+		return nil, err
+	}
 	publicKeyBytes := key.PubKey().Bytes()
 
 	return &AccountAuthenticator{
@@ -83,9 +87,14 @@ func (key *Ed25519PrivateKey) SimulationAuthenticator() *AccountAuthenticator {
 
 // PubKey returns the [Ed25519PublicKey] associated with the [Ed25519PrivateKey]
 //
+// The public key is still available after [Ed25519PrivateKey.Zeroize].
+//
 // Implements:
 //   - [Signer]
 func (key *Ed25519PrivateKey) PubKey() PublicKey {
+	if key.Inner == nil {
+		return &Ed25519PublicKey{Inner: key.zeroizedPubKey}
+	}
 	pubKey := key.Inner.Public()
 	return &Ed25519PublicKey{
 		pubKey.(ed25519.PublicKey),
@@ -104,15 +113,29 @@ func (key *Ed25519PrivateKey) AuthKey() *AuthenticationKey {
 
 //endregion
 
+// Zeroize overwrites the private key bytes in memory, and makes the key unusable.  Signing afterward returns
+// [ErrKeyZeroized], and the private key accessors return empty values, but the public key is kept, so the address and
+// authentication key are still available.  Copies of the key, e.g. from [Ed25519PrivateKey.Bytes], are not affected.
+func (key *Ed25519PrivateKey) Zeroize() {
+	if key.Inner != nil {
+		key.zeroizedPubKey = key.PubKey().(*Ed25519PublicKey).Inner
+	}
+	clear(key.Inner)
+	key.Inner = nil
+}
+
 //region Ed25519PrivateKey MessageSigner Implementation
 
 // SignMessage signs a message and returns the raw [Signature] without a [VerifyingKey] for verification
 //
-// Never returns an error.
+// Returns [ErrKeyZeroized] if the key has been zeroized, otherwise never returns an error.
 //
 // Implements:
 //   - [MessageSigner]
 func (key *Ed25519PrivateKey) SignMessage(msg []byte) (sig Signature, err error) {
+	if key.Inner == nil {
+		return nil, ErrKeyZeroized
+	}
 	sigBytes := ed25519.Sign(key.Inner, msg)
 	return &Ed25519Signature{Inner: [64]byte(sigBytes)}, nil
 }
@@ -137,11 +160,14 @@ func (key *Ed25519PrivateKey) VerifyingKey() VerifyingKey {
 
 //region Ed25519PrivateKey CryptoMaterial Implementation
 
-// Bytes returns the raw bytes of the [Ed25519PrivateKey]
+// Bytes returns the raw bytes of the [Ed25519PrivateKey], or nil if the key has been zeroized
 //
 // Implements:
 //   - [CryptoMaterial]
 func (key *Ed25519PrivateKey) Bytes() []byte {
+	if key.Inner == nil {
+		return nil
+	}
 	return key.Inner.Seed()
 }
 
@@ -160,19 +186,29 @@ func (key *Ed25519PrivateKey) FromBytes(bytes []byte) (err error) {
 		return fmt.Errorf("invalid ed25519 private key size %d", len(bytes))
 	}
 	key.Inner = ed25519.NewKeyFromSeed(bytes)
+	key.zeroizedPubKey = nil
 	return nil
 }
 
-// ToHex returns the hex string representation of the [Ed25519PrivateKey], with a leading 0x
+// ToHex returns the hex string representation of the [Ed25519PrivateKey], with a leading 0x, or an empty string if
+// the key has been zeroized
 //
 // Implements:
 //   - [CryptoMaterial]
 func (key *Ed25519PrivateKey) ToHex() string {
+	if key.Inner == nil {
+		return ""
+	}
 	return util.BytesToHex(key.Bytes())
 }
 
 // ToAIP80 formats the private key to AIP-80 compliant string
+//
+// Returns [ErrKeyZeroized] if the key has been zeroized.
 func (key *Ed25519PrivateKey) ToAIP80() (formattedString string, err error) {
+	if key.Inner == nil {
+		return "", ErrKeyZeroized
+	}
 	return FormatPrivateKey(key.ToHex(), PrivateKeyVariantEd25519)
 }
 
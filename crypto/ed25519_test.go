@@ -115,3 +115,48 @@ func TestEd25519SignatureWrongLength(t *testing.T) {
 	err := sig.FromBytes([]byte{0x01})
 	assert.Error(t, err)
 }
+
+func TestEd25519Zeroize(t *testing.T) {
+	key, err := GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	inner := key.Inner
+	_, err = key.Sign([]byte("hello"))
+	assert.NoError(t, err)
+
+	pubKey := key.PubKey()
+	authKey := key.AuthKey()
+
+	key.Zeroize()
+	assert.Equal(t, make([]byte, len(inner)), []byte(inner))
+	_, err = key.Sign([]byte("hello"))
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+	_, err = key.SignMessage([]byte("hello"))
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+
+	// The public key is kept, and the private key accessors are empty
+	assert.Equal(t, pubKey, key.PubKey())
+	assert.Equal(t, pubKey, key.VerifyingKey())
+	assert.Equal(t, authKey, key.AuthKey())
+	assert.Equal(t, pubKey, key.SimulationAuthenticator().PubKey())
+	assert.Nil(t, key.Bytes())
+	assert.Equal(t, "", key.ToHex())
+	_, err = key.ToAIP80()
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+	_, err = FormatAIP80PrivateKey(key)
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+	key.Zeroize()
+	assert.Equal(t, pubKey, key.PubKey())
+
+	// Zeroizing through a SingleSigner zeroizes the key underneath
+	key, err = GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	signer := NewSingleSigner(key)
+	singlePubKey := signer.PubKey()
+	singleAuthKey := signer.AuthKey()
+	signer.Zeroize()
+	_, err = signer.Sign([]byte("hello"))
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+	assert.Equal(t, singlePubKey, signer.PubKey())
+	assert.Equal(t, singleAuthKey, signer.AuthKey())
+	assert.Equal(t, singlePubKey, signer.SimulationAuthenticator().PubKey())
+}
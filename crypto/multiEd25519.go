@@ -3,6 +3,8 @@ package crypto
 import (
 	"crypto/ed25519"
 	"fmt"
+	"sort"
+
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/aptos-labs/aptos-go-sdk/internal/util"
 )
@@ -25,30 +27,57 @@ type MultiEd25519PublicKey struct {
 	SignaturesRequired uint8
 }
 
+// MaxMultiEd25519Keys is the maximum number of keys allowed in a [MultiEd25519PublicKey]
+const MaxMultiEd25519Keys = 32
+
+// NewMultiEd25519PublicKey creates a legacy MultiEd25519 public key from the given keys, requiring threshold signatures
+//
+// The order of the keys matters, as the signature bitmap refers to keys by their index.
+func NewMultiEd25519PublicKey(keys []*Ed25519PublicKey, threshold uint8) (*MultiEd25519PublicKey, error) {
+	if len(keys) == 0 || len(keys) > MaxMultiEd25519Keys {
+		return nil, fmt.Errorf("multi ed25519 public key must have between 1 and %d keys, got %d", MaxMultiEd25519Keys, len(keys))
+	}
+	if threshold == 0 || int(threshold) > len(keys) {
+		return nil, fmt.Errorf("multi ed25519 threshold must be between 1 and %d, got %d", len(keys), threshold)
+	}
+	for i, key := range keys {
+		if key == nil {
+			return nil, fmt.Errorf("multi ed25519 public key %d is nil", i)
+		}
+	}
+	return &MultiEd25519PublicKey{
+		PubKeys:            keys,
+		SignaturesRequired: threshold,
+	}, nil
+}
+
 //region MultiEd25519PublicKey VerifyingKey implementation
 
 // Verify verifies the signature against the message
 //
-// # This function will return true if the number of verified signatures is greater than or equal to the number of required signatures
+// # This function will return true if every signature matches the key marked in the bitmap, and the number of signatures is greater than or equal to the number of required signatures
 //
 // Implements:
 //   - [VerifyingKey]
 func (key *MultiEd25519PublicKey) Verify(msg []byte, signature Signature) bool {
 	switch sig := signature.(type) {
 	case *MultiEd25519Signature:
-		verified := uint8(0)
-		// TODO: Verify with bitmap, and check that this works properly
-		for i, pubKey := range key.PubKeys {
-			if pubKey.Verify(msg, sig.Signatures[i]) {
-				verified++
+		indices := sig.Indices()
+		if len(indices) != len(sig.Signatures) || len(indices) < int(key.SignaturesRequired) {
+			return false
+		}
+		for i, index := range indices {
+			if int(index) >= len(key.PubKeys) {
+				return false
+			}
+			if !key.PubKeys[index].Verify(msg, sig.Signatures[i]) {
+				return false
 			}
 		}
-
-		return verified >= key.SignaturesRequired
+		return true
 	default:
 		return false
 	}
-
 }
 
 //endregion
@@ -265,6 +294,54 @@ type MultiEd25519Signature struct {
 	Bitmap     [MultiEd25519BitmapLen]byte
 }
 
+// IndexedEd25519Signature is a signature along with the index of the signing key in the [MultiEd25519PublicKey]
+type IndexedEd25519Signature struct {
+	Index     uint8
+	Signature *Ed25519Signature
+}
+
+// NewMultiEd25519Signature combines individual signatures into a [MultiEd25519Signature], building the bitmap from
+// the indices of the signing keys
+//
+// Returns an error if an index is out of range or is used more than once.
+func NewMultiEd25519Signature(signatures []IndexedEd25519Signature) (*MultiEd25519Signature, error) {
+	// Signatures must be in the same order as the public keys
+	sorted := make([]IndexedEd25519Signature, len(signatures))
+	copy(sorted, signatures)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+
+	multiSig := &MultiEd25519Signature{}
+	for _, sig := range sorted {
+		if sig.Index >= MaxMultiEd25519Keys {
+			return nil, fmt.Errorf("index %d is greater than the maximum number of keys %d", sig.Index, MaxMultiEd25519Keys)
+		}
+		if sig.Signature == nil {
+			return nil, fmt.Errorf("signature for index %d is nil", sig.Index)
+		}
+		numByte, numBit := KeyIndices(sig.Index)
+		if multiSig.Bitmap[numByte]&(128>>numBit) != 0 {
+			return nil, fmt.Errorf("index %d already in bitmap", sig.Index)
+		}
+		multiSig.Bitmap[numByte] |= 128 >> numBit
+		multiSig.Signatures = append(multiSig.Signatures, sig.Signature)
+	}
+	return multiSig, nil
+}
+
+// Indices returns the indices of the keys marked as signers in the bitmap, in ascending order
+func (e *MultiEd25519Signature) Indices() []uint8 {
+	indices := make([]uint8, 0)
+	for i := uint8(0); i < MaxMultiEd25519Keys; i++ {
+		numByte, numBit := KeyIndices(i)
+		if e.Bitmap[numByte]&(128>>numBit) != 0 {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
 //region MultiEd25519Signature CryptoMaterial implementation
 
 // Bytes serializes the signature to bytes
@@ -3,6 +3,7 @@ package crypto
 import (
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
 	"testing"
 )
 
@@ -61,6 +62,75 @@ func TestMultiEd25519KeySerialization(t *testing.T) {
 
 }
 
+func TestMultiEd25519RoundTrip(t *testing.T) {
+	privateKeys := make([]*Ed25519PrivateKey, 3)
+	publicKeys := make([]*Ed25519PublicKey, 3)
+	for i := range privateKeys {
+		key, err := GenerateEd25519PrivateKey()
+		assert.NoError(t, err)
+		privateKeys[i] = key
+		publicKeys[i] = key.PubKey().(*Ed25519PublicKey)
+	}
+	publicKey, err := NewMultiEd25519PublicKey(publicKeys, 2)
+	assert.NoError(t, err)
+
+	// The authentication key is the hash of the public key bytes with the MultiEd25519 scheme
+	expectedAuthKey := sha3.Sum256(append(publicKey.Bytes(), MultiEd25519Scheme))
+	assert.Equal(t, expectedAuthKey[:], publicKey.AuthKey()[:])
+
+	message := []byte("legacy multisig")
+	sign := func(index uint8) IndexedEd25519Signature {
+		sig, err := privateKeys[index].SignMessage(message)
+		assert.NoError(t, err)
+		return IndexedEd25519Signature{Index: index, Signature: sig.(*Ed25519Signature)}
+	}
+
+	// Signatures out of order are sorted into the bitmap order
+	signature, err := NewMultiEd25519Signature([]IndexedEd25519Signature{sign(2), sign(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, [MultiEd25519BitmapLen]byte{0xA0, 0, 0, 0}, signature.Bitmap)
+	assert.Equal(t, []uint8{0, 2}, signature.Indices())
+	assert.True(t, publicKey.Verify(message, signature))
+	assert.False(t, publicKey.Verify([]byte("other message"), signature))
+
+	// Round trip the authenticator through BCS
+	auth := &AccountAuthenticator{
+		Variant: AccountAuthenticatorMultiEd25519,
+		Auth:    &MultiEd25519Authenticator{PubKey: publicKey, Sig: signature},
+	}
+	authBytes, err := bcs.Serialize(auth)
+	assert.NoError(t, err)
+	authDeserialized := &AccountAuthenticator{}
+	err = bcs.Deserialize(authDeserialized, authBytes)
+	assert.NoError(t, err)
+	assert.True(t, authDeserialized.Verify(message))
+	assert.Equal(t, publicKey.AuthKey(), authDeserialized.PubKey().AuthKey())
+
+	// Not enough signatures
+	signature, err = NewMultiEd25519Signature([]IndexedEd25519Signature{sign(1)})
+	assert.NoError(t, err)
+	assert.False(t, publicKey.Verify(message, signature))
+
+	// Signature doesn't match the key in the bitmap
+	mismatched := sign(1)
+	mismatched.Index = 2
+	signature, err = NewMultiEd25519Signature([]IndexedEd25519Signature{sign(0), mismatched})
+	assert.NoError(t, err)
+	assert.False(t, publicKey.Verify(message, signature))
+
+	// Invalid inputs
+	_, err = NewMultiEd25519Signature([]IndexedEd25519Signature{sign(0), sign(0)})
+	assert.Error(t, err)
+	_, err = NewMultiEd25519Signature([]IndexedEd25519Signature{{Index: MaxMultiEd25519Keys, Signature: sign(0).Signature}})
+	assert.Error(t, err)
+	_, err = NewMultiEd25519PublicKey(publicKeys, 4)
+	assert.Error(t, err)
+	_, err = NewMultiEd25519PublicKey(publicKeys, 0)
+	assert.Error(t, err)
+	_, err = NewMultiEd25519PublicKey(nil, 1)
+	assert.Error(t, err)
+}
+
 func createMultiEd25519Key(t *testing.T) (
 	*Ed25519PrivateKey,
 	*Ed25519PrivateKey,
@@ -76,10 +146,8 @@ func createMultiEd25519Key(t *testing.T) (
 	assert.NoError(t, err)
 	pubkey2 := key2.PubKey().(*Ed25519PublicKey)
 
-	publicKey := &MultiEd25519PublicKey{
-		PubKeys:            []*Ed25519PublicKey{pubkey1, pubkey2},
-		SignaturesRequired: 2,
-	}
+	publicKey, err := NewMultiEd25519PublicKey([]*Ed25519PublicKey{pubkey1, pubkey2}, 2)
+	assert.NoError(t, err)
 
 	return key1, key2, pubkey1, pubkey2, publicKey
 }
@@ -90,12 +158,10 @@ func createMultiEd25519Signature(t *testing.T, key1 *Ed25519PrivateKey, key2 *Ed
 	sig2, err := key2.SignMessage(message)
 	assert.NoError(t, err)
 
-	// TODO: This signature should be built easier, ergonomics to fix this late
-	return &MultiEd25519Signature{
-		Signatures: []*Ed25519Signature{
-			sig1.(*Ed25519Signature),
-			sig2.(*Ed25519Signature),
-		},
-		Bitmap: [4]byte([]byte("c0000000")),
-	}
+	signature, err := NewMultiEd25519Signature([]IndexedEd25519Signature{
+		{Index: 0, Signature: sig1.(*Ed25519Signature)},
+		{Index: 1, Signature: sig2.(*Ed25519Signature)},
+	})
+	assert.NoError(t, err)
+	return signature
 }
@@ -33,12 +33,17 @@ type MultiKey struct {
 func (key *MultiKey) Verify(msg []byte, signature Signature) bool {
 	switch sig := signature.(type) {
 	case *MultiKeySignature:
-		if key.SignaturesRequired > uint8(len(sig.Signatures)) {
+		// Every signature must have exactly one key in the bitmap, and there must be enough of them
+		keyIndices := sig.Bitmap.Indices()
+		if len(keyIndices) != len(sig.Signatures) || int(key.SignaturesRequired) > len(sig.Signatures) {
 			return false
 		}
 
 		// Convert to individual authenticators, and verify
-		for sigIndex, keyIndex := range sig.Bitmap.Indices() {
+		for sigIndex, keyIndex := range keyIndices {
+			if int(keyIndex) >= len(key.PubKeys) {
+				return false
+			}
 			authenticator := AccountAuthenticator{}
 			err := authenticator.FromKeyAndSignature(key.PubKeys[keyIndex], sig.Signatures[sigIndex])
 			if err != nil {
@@ -57,6 +62,35 @@ func (key *MultiKey) Verify(msg []byte, signature Signature) bool {
 
 //endregion
 
+// SelectSigners chooses which keys to sign with, from the indices of the keys that are available to sign.  The
+// available indices are in order of preference, e.g. cheapest or most reliable first, and the first
+// SignaturesRequired of them are used.  The chosen indices are returned in ascending order.
+//
+// Returns an error if an index is out of range or repeated, or if there aren't enough keys available to meet the
+// threshold.
+func (key *MultiKey) SelectSigners(available []uint8) ([]uint8, error) {
+	seen := make(map[uint8]bool, len(available))
+	for _, index := range available {
+		if int(index) >= len(key.PubKeys) {
+			return nil, fmt.Errorf("key index %d out of range, there are %d keys", index, len(key.PubKeys))
+		}
+		if seen[index] {
+			return nil, fmt.Errorf("key index %d is repeated", index)
+		}
+		seen[index] = true
+	}
+	if len(available) < int(key.SignaturesRequired) {
+		return nil, fmt.Errorf("%d keys available, but %d signatures are required", len(available), key.SignaturesRequired)
+	}
+
+	selected := make([]uint8, key.SignaturesRequired)
+	copy(selected, available)
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i] < selected[j]
+	})
+	return selected, nil
+}
+
 //region MultiKey PublicKey implementation
 
 // AuthKey converts the public key to an authentication key
@@ -136,13 +170,10 @@ func (key *MultiKey) MarshalBCS(ser *bcs.Serializer) {
 // Implements:
 //   - [bcs.Unmarshaler]
 func (key *MultiKey) UnmarshalBCS(des *bcs.Deserializer) {
-	length := des.Uleb128()
-	key.PubKeys = make([]*AnyPublicKey, length)
-
-	for i := uint32(0); i < length; i++ {
-		key.PubKeys[i] = &AnyPublicKey{}
-		des.Struct(key.PubKeys[i])
-	}
+	key.PubKeys = bcs.DeserializeSequenceWithFunction(des, func(des *bcs.Deserializer, out **AnyPublicKey) {
+		*out = &AnyPublicKey{}
+		des.Struct(*out)
+	})
 	key.SignaturesRequired = des.U8()
 }
 
@@ -261,13 +292,10 @@ func (e *MultiKeySignature) MarshalBCS(ser *bcs.Serializer) {
 // Implements:
 //   - [bcs.Unmarshaler]
 func (e *MultiKeySignature) UnmarshalBCS(des *bcs.Deserializer) {
-	length := des.Uleb128()
-	e.Signatures = make([]*AnySignature, length)
-
-	for i := uint32(0); i < length; i++ {
-		e.Signatures[i] = &AnySignature{}
-		des.Struct(e.Signatures[i])
-	}
+	e.Signatures = bcs.DeserializeSequenceWithFunction(des, func(des *bcs.Deserializer, out **AnySignature) {
+		*out = &AnySignature{}
+		des.Struct(*out)
+	})
 
 	e.Bitmap.UnmarshalBCS(des)
 }
@@ -371,7 +399,7 @@ func (bm *MultiKeyBitmap) ContainsKey(index uint8) bool {
 	if int(numByte) >= len(bm.inner) {
 		return false
 	}
-	return (bm.inner[numByte] & (128 >> numBit)) == 1
+	return (bm.inner[numByte] & (128 >> numBit)) != 0
 }
 
 // AddKey adds the value to the map, returning an error if it is already added
@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"encoding/hex"
+	"fmt"
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -49,6 +50,31 @@ func TestMultiKey(t *testing.T) {
 	assert.True(t, auth.Verify(message))
 }
 
+func TestMultiKeySelectSigners(t *testing.T) {
+	_, _, _, _, _, _, publicKey := createMultiKey(t)
+
+	// The preferred keys are used, in ascending order
+	selected, err := publicKey.SelectSigners([]uint8{2, 0, 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0, 2}, selected)
+
+	selected, err = publicKey.SelectSigners([]uint8{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{1, 2}, selected)
+
+	// Not enough keys
+	_, err = publicKey.SelectSigners([]uint8{1})
+	assert.Error(t, err)
+	_, err = publicKey.SelectSigners(nil)
+	assert.Error(t, err)
+
+	// Out of range and repeated keys
+	_, err = publicKey.SelectSigners([]uint8{0, 3})
+	assert.Error(t, err)
+	_, err = publicKey.SelectSigners([]uint8{1, 1})
+	assert.Error(t, err)
+}
+
 func TestMultiKeySerialization(t *testing.T) {
 	key1, _, key3, _, _, _, publicKey := createMultiKey(t)
 
@@ -148,3 +174,95 @@ func createMultiKeySignature(t *testing.T, index1 uint8, key1 *SingleSigner, ind
 	assert.NoError(t, err)
 	return sig
 }
+
+// multiKeyBenchmarkConfigs are realistic K-of-N configurations to benchmark
+var multiKeyBenchmarkConfigs = []struct {
+	required uint8
+	total    uint8
+}{
+	{2, 3},
+	{3, 5},
+	{16, 32},
+}
+
+// createBenchmarkMultiKey creates a K-of-N MultiKey, alternating Ed25519 and Secp256k1 keys
+func createBenchmarkMultiKey(b *testing.B, required uint8, total uint8) ([]*SingleSigner, *MultiKey) {
+	signers := make([]*SingleSigner, total)
+	publicKey := &MultiKey{SignaturesRequired: required}
+	for i := range signers {
+		var privateKey MessageSigner
+		var err error
+		if i%2 == 0 {
+			privateKey, err = GenerateEd25519PrivateKey()
+		} else {
+			privateKey, err = GenerateSecp256k1Key()
+		}
+		if err != nil {
+			b.Fatal(err)
+		}
+		signers[i] = NewSingleSigner(privateKey)
+		publicKey.PubKeys = append(publicKey.PubKeys, signers[i].PubKey().(*AnyPublicKey))
+	}
+	return signers, publicKey
+}
+
+// signBenchmarkMultiKey signs with the first required signers, and combines the signatures
+func signBenchmarkMultiKey(signers []*SingleSigner, required uint8, message []byte) (*MultiKeySignature, error) {
+	signatures := make([]IndexedAnySignature, required)
+	for i := range signatures {
+		signature, err := signers[i].SignMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		signatures[i] = IndexedAnySignature{Index: uint8(i), Signature: signature.(*AnySignature)}
+	}
+	return NewMultiKeySignature(signatures)
+}
+
+func BenchmarkMultiKeySign(b *testing.B) {
+	message := []byte("hello world")
+	for _, config := range multiKeyBenchmarkConfigs {
+		b.Run(fmt.Sprintf("%d-of-%d", config.required, config.total), func(b *testing.B) {
+			signers, _ := createBenchmarkMultiKey(b, config.required, config.total)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				if _, err := signBenchmarkMultiKey(signers, config.required, message); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMultiKeyVerify(b *testing.B) {
+	message := []byte("hello world")
+	for _, config := range multiKeyBenchmarkConfigs {
+		b.Run(fmt.Sprintf("%d-of-%d", config.required, config.total), func(b *testing.B) {
+			signers, publicKey := createBenchmarkMultiKey(b, config.required, config.total)
+			signature, err := signBenchmarkMultiKey(signers, config.required, message)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				if !publicKey.Verify(message, signature) {
+					b.Fatal("signature failed to verify")
+				}
+			}
+		})
+	}
+}
+
+func TestMultiKeyCraftedLength(t *testing.T) {
+	// Length prefixes past the input are rejected rather than allocated
+	crafted := []byte{0xff, 0xff, 0xff, 0xff, 0x07, 0x01}
+	assert.Error(t, bcs.Deserialize(&MultiKey{}, crafted))
+	assert.Error(t, bcs.Deserialize(&MultiKeySignature{}, crafted))
+
+	var limitErr *bcs.LimitExceededError
+	crafted = []byte{0xff, 0xff, 0xff, 0xff, 0x0f, 0x01}
+	assert.ErrorAs(t, bcs.Deserialize(&MultiKey{}, crafted), &limitErr)
+	assert.ErrorAs(t, bcs.Deserialize(&MultiKeySignature{}, crafted), &limitErr)
+}
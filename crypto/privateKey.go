@@ -1,12 +1,16 @@
 package crypto
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/aptos-labs/aptos-go-sdk/internal/util"
 )
 
+// ErrKeyZeroized is returned when signing with a private key that has been zeroized, e.g. with [Ed25519PrivateKey.Zeroize]
+var ErrKeyZeroized = errors.New("private key has been zeroized")
+
 // PrivateKeyVariant represents the type of private key
 type PrivateKeyVariant string
 
@@ -91,3 +95,65 @@ func ParsePrivateKey(value any, keyType PrivateKeyVariant, strict ...bool) (byte
 		return nil, fmt.Errorf("unsupported private key type: must be string or []byte")
 	}
 }
+
+// ParseAIP80PrivateKey parses an AIP-80 compliant private key string e.g. `ed25519-priv-0x...` or
+// `secp256k1-priv-0x...` into the private key type given by its prefix, either an [Ed25519PrivateKey] or a
+// [Secp256k1PrivateKey].  It is the inverse of [FormatAIP80PrivateKey].
+//
+// Returns an error if the prefix is missing or unknown, or if the key is invalid.  To parse a key of a known type
+// without a prefix, use FromHex on the key type instead.
+func ParseAIP80PrivateKey(s string) (MessageSigner, error) {
+	switch {
+	case strings.HasPrefix(s, AIP80Prefixes[PrivateKeyVariantEd25519]):
+		key := &Ed25519PrivateKey{}
+		if err := key.FromHex(s); err != nil {
+			return nil, err
+		}
+		return key, nil
+	case strings.HasPrefix(s, AIP80Prefixes[PrivateKeyVariantSecp256k1]):
+		key := &Secp256k1PrivateKey{}
+		if err := key.FromHex(s); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+
+	if keyType, _, found := strings.Cut(s, "-priv-"); found {
+		return nil, fmt.Errorf("unknown AIP-80 private key type %s", keyType)
+	}
+	return nil, fmt.Errorf("missing AIP-80 private key prefix, must be an AIP-80 compliant string e.g. %s0x...", AIP80Prefixes[PrivateKeyVariantEd25519])
+}
+
+// FormatAIP80PrivateKey formats a private key as an AIP-80 compliant string, with the prefix for its type e.g.
+// `ed25519-priv-0x...`.  It is the inverse of [ParseAIP80PrivateKey].
+//
+// Returns an error if the key type has no AIP-80 prefix.
+func FormatAIP80PrivateKey(key MessageSigner) (string, error) {
+	switch key := key.(type) {
+	case *Ed25519PrivateKey:
+		return key.ToAIP80()
+	case *Secp256k1PrivateKey:
+		return key.ToAIP80()
+	default:
+		return "", fmt.Errorf("unsupported private key type for AIP-80: %T", key)
+	}
+}
+
+// ParseSigner parses an AIP-80 compliant private key string e.g. `ed25519-priv-0x...` or `secp256k1-priv-0x...`, and
+// returns a [Signer] for the key type in the prefix.
+//
+// Ed25519 keys are returned as an [Ed25519PrivateKey], which signs with the legacy [AccountAuthenticatorEd25519]
+// scheme, same as the accounts created by the SDK.  Wrap it with [NewSingleSigner] to use the SingleKey scheme instead.
+// Secp256k1 keys are only supported with the SingleKey scheme, so they are returned wrapped in a [SingleSigner].
+//
+// Returns an error if the prefix is unknown or missing, or if the key is invalid.
+func ParseSigner(s string) (Signer, error) {
+	key, err := ParseAIP80PrivateKey(s)
+	if err != nil {
+		return nil, err
+	}
+	if ed25519Key, ok := key.(*Ed25519PrivateKey); ok {
+		return ed25519Key, nil
+	}
+	return NewSingleSigner(key), nil
+}
@@ -0,0 +1,52 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSigner(t *testing.T) {
+	signer, err := ParseSigner(testEd25519PrivateKey)
+	assert.NoError(t, err)
+	_, ok := signer.(*Ed25519PrivateKey)
+	assert.True(t, ok)
+	assert.Equal(t, testEd25519Address, signer.AuthKey().ToHex())
+
+	signer, err = ParseSigner(testSecp256k1PrivateKey)
+	assert.NoError(t, err)
+	_, ok = signer.(*SingleSigner)
+	assert.True(t, ok)
+	assert.Equal(t, testSecp256k1Address, signer.AuthKey().ToHex())
+
+	// The prefix is required, as the key type can't be inferred from the bytes
+	_, err = ParseSigner(testEd25519PrivateKeyHex)
+	assert.Error(t, err)
+	_, err = ParseSigner("ed25519-priv-0xzz")
+	assert.Error(t, err)
+	_, err = ParseSigner("ed25519-priv-0x0102")
+	assert.Error(t, err)
+}
+
+func TestAIP80PrivateKeyRoundTrip(t *testing.T) {
+	for _, input := range []string{testEd25519PrivateKey, testSecp256k1PrivateKey} {
+		key, err := ParseAIP80PrivateKey(input)
+		assert.NoError(t, err)
+		formatted, err := FormatAIP80PrivateKey(key)
+		assert.NoError(t, err)
+		assert.Equal(t, input, formatted)
+	}
+
+	key, err := ParseAIP80PrivateKey(testSecp256k1PrivateKey)
+	assert.NoError(t, err)
+	_, ok := key.(*Secp256k1PrivateKey)
+	assert.True(t, ok)
+
+	_, err = ParseAIP80PrivateKey(testEd25519PrivateKeyHex)
+	assert.ErrorContains(t, err, "missing AIP-80 private key prefix")
+	_, err = ParseAIP80PrivateKey("p256-priv-0x0102")
+	assert.ErrorContains(t, err, "unknown AIP-80 private key type p256")
+
+	_, err = FormatAIP80PrivateKey(nil)
+	assert.Error(t, err)
+}
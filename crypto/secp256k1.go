@@ -30,6 +30,8 @@ const Secp256k1SignatureLength = 64
 //   - [bcs.Struct]
 type Secp256k1PrivateKey struct {
 	Inner *secp256k1.PrivateKey // Inner is the actual private key
+
+	zeroizedPubKey *secp256k1.PublicKey // zeroizedPubKey is the public key kept after [Secp256k1PrivateKey.Zeroize]
 }
 
 // GenerateSecp256k1Key generates a new [Secp256k1PrivateKey]
@@ -39,16 +41,32 @@ func GenerateSecp256k1Key() (*Secp256k1PrivateKey, error) {
 		return nil, err
 	}
 
-	return &Secp256k1PrivateKey{priv}, nil
+	return &Secp256k1PrivateKey{Inner: priv}, nil
+}
+
+// Zeroize overwrites the private key in memory, and makes the key unusable.  Signing afterward returns
+// [ErrKeyZeroized], and the private key accessors return empty values, but the public key is kept, so the address and
+// authentication key are still available.  Copies of the key, e.g. from [Secp256k1PrivateKey.Bytes], are not affected.
+func (key *Secp256k1PrivateKey) Zeroize() {
+	if key.Inner != nil {
+		key.zeroizedPubKey = key.Inner.PubKey()
+		key.Inner.Zero()
+	}
+	key.Inner = nil
 }
 
 //region Secp256k1PrivateKey MessageSigner
 
 // VerifyingKey returns the corresponding public key for the private key
 //
+// The public key is still available after [Secp256k1PrivateKey.Zeroize].
+//
 // Implements:
 //   - [MessageSigner]
 func (key *Secp256k1PrivateKey) VerifyingKey() VerifyingKey {
+	if key.Inner == nil {
+		return &Secp256k1PublicKey{Inner: key.zeroizedPubKey}
+	}
 	return &Secp256k1PublicKey{
 		key.Inner.PubKey(),
 	}
@@ -64,9 +82,14 @@ func (key *Secp256k1PrivateKey) EmptySignature() Signature {
 
 // SignMessage signs a message and returns the raw [Signature] without a [PublicKey] for verification
 //
+// Returns [ErrKeyZeroized] if the key has been zeroized.
+//
 // Implements:
 //   - [MessageSigner]
 func (key *Secp256k1PrivateKey) SignMessage(msg []byte) (sig Signature, err error) {
+	if key.Inner == nil {
+		return nil, ErrKeyZeroized
+	}
 	hash := util.Sha3256Hash([][]byte{msg})
 	signature := ecdsa.Sign(key.Inner, hash)
 	return &Secp256k1Signature{signature}, nil
@@ -76,11 +99,14 @@ func (key *Secp256k1PrivateKey) SignMessage(msg []byte) (sig Signature, err erro
 
 //region Secp256k1PrivateKey CryptoMaterial
 
-// Bytes outputs the raw byte representation of the [Secp256k1PrivateKey]
+// Bytes outputs the raw byte representation of the [Secp256k1PrivateKey], or nil if the key has been zeroized
 //
 // Implements:
 //   - [CryptoMaterial]
 func (key *Secp256k1PrivateKey) Bytes() []byte {
+	if key.Inner == nil {
+		return nil
+	}
 	return key.Inner.Serialize()
 }
 
@@ -99,19 +125,28 @@ func (key *Secp256k1PrivateKey) FromBytes(bytes []byte) (err error) {
 		return fmt.Errorf("invalid secp256k1 private key size %d", len(bytes))
 	}
 	key.Inner = secp256k1.PrivKeyFromBytes(bytes)
+	key.zeroizedPubKey = nil
 	return nil
 }
 
-// ToHex serializes the private key to a hex string
+// ToHex serializes the private key to a hex string, or an empty string if the key has been zeroized
 //
 // Implements:
 //   - [CryptoMaterial]
 func (key *Secp256k1PrivateKey) ToHex() string {
+	if key.Inner == nil {
+		return ""
+	}
 	return util.BytesToHex(key.Bytes())
 }
 
 // ToAIP80 formats the private key to AIP-80 compliant string
+//
+// Returns [ErrKeyZeroized] if the key has been zeroized.
 func (key *Secp256k1PrivateKey) ToAIP80() (formattedString string, err error) {
+	if key.Inner == nil {
+		return "", ErrKeyZeroized
+	}
 	return FormatPrivateKey(key.ToHex(), PrivateKeyVariantSecp256k1)
 }
 
@@ -153,13 +188,21 @@ type Secp256k1PublicKey struct {
 
 // Verify verifies the signature of a message
 //
-// Returns true if the signature is valid and a [Secp256k1Signature], false otherwise
+// Returns true if the signature is valid and a [Secp256k1Signature], false otherwise.  Signatures with a high s value
+// are malleable, and are rejected, to match on-chain verification.
 //
 // Implements:
 //   - [VerifyingKey]
 func (key *Secp256k1PublicKey) Verify(msg []byte, sig Signature) bool {
 	switch sig := sig.(type) {
 	case *Secp256k1Signature:
+		if sig.Inner == nil || key.Inner == nil {
+			return false
+		}
+		sValue := sig.Inner.S()
+		if sValue.IsOverHalfOrder() {
+			return false
+		}
 		// Verification requires to pass the SHA-256 hash of the message
 		hash := util.Sha3256Hash([][]byte{msg})
 		return sig.Inner.Verify(hash, key.Inner)
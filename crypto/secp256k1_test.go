@@ -5,6 +5,8 @@ import (
 
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/aptos-labs/aptos-go-sdk/internal/util"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -204,3 +206,64 @@ func TestSecp256k1Signature_RecoverPublicKeyFromSignatureWithRecoveryBit(t *test
 	assert.True(t, recoveredKey.Verify(message, signature))
 	assert.Equal(t, publicKey.ToHex(), recoveredKey.ToHex())
 }
+
+func TestSecp256k1Zeroize(t *testing.T) {
+	key, err := GenerateSecp256k1Key()
+	assert.NoError(t, err)
+	inner := key.Inner
+	_, err = key.SignMessage([]byte("hello"))
+	assert.NoError(t, err)
+
+	pubKey := key.VerifyingKey()
+	signer := NewSingleSigner(key)
+	authKey := signer.AuthKey()
+
+	key.Zeroize()
+	assert.True(t, inner.Key.IsZero())
+	_, err = key.SignMessage([]byte("hello"))
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+	_, err = signer.Sign([]byte("hello"))
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+
+	// The public key is kept, and the private key accessors are empty
+	assert.Equal(t, pubKey, key.VerifyingKey())
+	assert.Equal(t, authKey, signer.AuthKey())
+	assert.Nil(t, key.Bytes())
+	assert.Equal(t, "", key.ToHex())
+	_, err = key.ToAIP80()
+	assert.ErrorIs(t, err, ErrKeyZeroized)
+	key.Zeroize()
+	assert.Equal(t, pubKey, key.VerifyingKey())
+}
+
+func TestSecp256k1RejectsHighS(t *testing.T) {
+	// The same signature as testSecp256k1Signature, with s replaced by n - s
+	const highSSignature = "0xd0d634e843b61339473b028105930ace022980708b2855954b977da09df84a77f3f4973d63735e4abf65af7a4f13d9c2d2a099aa2b4ba95d044f6b4851c45377"
+
+	publicKey := &Secp256k1PublicKey{}
+	err := publicKey.FromHex(testSecp256k1PublicKey)
+	assert.NoError(t, err)
+	message, err := util.ParseHex(testSecp256k1MessageEncoded)
+	assert.NoError(t, err)
+
+	lowS := &Secp256k1Signature{}
+	err = lowS.FromHex(testSecp256k1Signature)
+	assert.NoError(t, err)
+	assert.True(t, publicKey.Verify(message, lowS))
+
+	// Deserializing rejects the high s signature
+	highS := &Secp256k1Signature{}
+	assert.Error(t, highS.FromHex(highSSignature))
+
+	// Verifying rejects it even if it's built directly
+	highSBytes, err := util.ParseHex(highSSignature)
+	assert.NoError(t, err)
+	var r, s secp256k1.ModNScalar
+	r.SetByteSlice(highSBytes[:32])
+	s.SetByteSlice(highSBytes[32:])
+	highS = &Secp256k1Signature{Inner: ecdsa.NewSignature(&r, &s)}
+	assert.False(t, publicKey.Verify(message, highS))
+
+	// Missing values are rejected, rather than panicking
+	assert.False(t, publicKey.Verify(message, &Secp256k1Signature{}))
+}
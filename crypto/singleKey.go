@@ -22,6 +22,14 @@ func NewSingleSigner(input MessageSigner) *SingleSigner {
 	return &SingleSigner{Signer: input}
 }
 
+// Zeroize zeroizes the underlying private key if it supports it, e.g. [Ed25519PrivateKey.Zeroize].  Signing afterward
+// returns [ErrKeyZeroized].
+func (key *SingleSigner) Zeroize() {
+	if zeroizer, ok := key.Signer.(interface{ Zeroize() }); ok {
+		zeroizer.Zeroize()
+	}
+}
+
 // SignMessage similar, but doesn't implement [MessageSigner] so there's no circular usage
 func (key *SingleSigner) SignMessage(msg []byte) (Signature, error) {
 	signature, err := key.Signer.SignMessage(msg)
@@ -152,6 +160,30 @@ func ToAnyPublicKey(key VerifyingKey) (*AnyPublicKey, error) {
 	return out, nil
 }
 
+// NewAnyPublicKey builds an [AnyPublicKey] from the variant and the raw bytes of the inner public key.  This is the
+// inverse of reading [AnyPublicKey.Variant] and the inner key's Bytes.
+//
+// Returns an error if the variant is unknown, or the bytes aren't a valid key of that variant e.g. a Secp256k1 key
+// must be [Secp256k1PublicKeyLength] bytes uncompressed.
+func NewAnyPublicKey(variant AnyPublicKeyVariant, keyBytes []byte) (*AnyPublicKey, error) {
+	var pubKey VerifyingKey
+	switch variant {
+	case AnyPublicKeyVariantEd25519:
+		pubKey = &Ed25519PublicKey{}
+	case AnyPublicKeyVariantSecp256k1:
+		if len(keyBytes) != Secp256k1PublicKeyLength {
+			return nil, fmt.Errorf("invalid secp256k1 public key size %d, expected %d", len(keyBytes), Secp256k1PublicKeyLength)
+		}
+		pubKey = &Secp256k1PublicKey{}
+	default:
+		return nil, fmt.Errorf("unknown public key variant: %d", variant)
+	}
+	if err := pubKey.FromBytes(keyBytes); err != nil {
+		return nil, err
+	}
+	return &AnyPublicKey{Variant: variant, PubKey: pubKey}, nil
+}
+
 //region AnyPublicKey VerifyingKey implementation
 
 // Verify verifies the signature against the message
@@ -287,6 +319,26 @@ type AnySignature struct {
 	Signature Signature
 }
 
+// NewAnySignature builds an [AnySignature] from the variant and the raw bytes of the inner signature.  This is the
+// inverse of reading [AnySignature.Variant] and the inner signature's Bytes.
+//
+// Returns an error if the variant is unknown, or the bytes aren't a valid signature of that variant.
+func NewAnySignature(variant AnySignatureVariant, signatureBytes []byte) (*AnySignature, error) {
+	var signature Signature
+	switch variant {
+	case AnySignatureVariantEd25519:
+		signature = &Ed25519Signature{}
+	case AnySignatureVariantSecp256k1:
+		signature = &Secp256k1Signature{}
+	default:
+		return nil, fmt.Errorf("unknown signature variant: %d", variant)
+	}
+	if err := signature.FromBytes(signatureBytes); err != nil {
+		return nil, err
+	}
+	return &AnySignature{Variant: variant, Signature: signature}, nil
+}
+
 // region AnySignature CryptoMaterial implementation
 
 // Bytes returns the raw bytes of the [AnySignature]
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAnyPublicKeyAndSignature(t *testing.T) {
+	message := []byte("hello")
+	for _, generate := range []func() (MessageSigner, error){
+		func() (MessageSigner, error) { return GenerateEd25519PrivateKey() },
+		func() (MessageSigner, error) { return GenerateSecp256k1Key() },
+	} {
+		privateKey, err := generate()
+		assert.NoError(t, err)
+		signer := NewSingleSigner(privateKey)
+		expectedKey := signer.PubKey().(*AnyPublicKey)
+		authenticator, err := signer.Sign(message)
+		assert.NoError(t, err)
+		expectedSignature := authenticator.Signature().(*AnySignature)
+
+		// Rebuild from the variant and the inner bytes
+		pubKey, err := NewAnyPublicKey(expectedKey.Variant, expectedKey.PubKey.Bytes())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedKey.Bytes(), pubKey.Bytes())
+		signature, err := NewAnySignature(expectedSignature.Variant, expectedSignature.Signature.Bytes())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedSignature.Bytes(), signature.Bytes())
+		assert.True(t, pubKey.Verify(message, signature))
+
+		// The inner bytes must match the variant
+		_, err = NewAnyPublicKey(expectedKey.Variant, expectedKey.PubKey.Bytes()[1:])
+		assert.Error(t, err)
+		_, err = NewAnySignature(expectedSignature.Variant, expectedSignature.Signature.Bytes()[1:])
+		assert.Error(t, err)
+	}
+
+	// Compressed Secp256k1 keys aren't accepted on chain
+	secpKey, err := GenerateSecp256k1Key()
+	assert.NoError(t, err)
+	_, err = NewAnyPublicKey(AnyPublicKeyVariantSecp256k1, secpKey.Inner.PubKey().SerializeCompressed())
+	assert.Error(t, err)
+
+	_, err = NewAnyPublicKey(AnyPublicKeyVariant(5), []byte{1})
+	assert.Error(t, err)
+	_, err = NewAnySignature(AnySignatureVariant(5), []byte{1})
+	assert.Error(t, err)
+}
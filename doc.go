@@ -14,7 +14,7 @@
 //
 //	// Create an account, and fund it
 //	account := NewEd25519Account()
-//	err := client.Fund(account.AccountAddress())
+//	_, err := client.Fund(account.AccountAddress(), 100_000_000)
 //	if err != nil {
 //	  panic(fmt.Sprintf("Failed to fund account %s %w", account.AccountAddress().ToString(), err))
 //	}
@@ -1,8 +1,63 @@
 package aptos
 
+import (
+	"fmt"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+)
+
 // EstimateGasInfo is returned by #EstimateGasPrice()
 type EstimateGasInfo struct {
 	DeprioritizedGasEstimate uint64 `json:"deprioritized_gas_estimate"` // DeprioritizedGasEstimate is the gas estimate for a transaction that is willing to be deprioritized and pay less
 	GasEstimate              uint64 `json:"gas_estimate"`               // GasEstimate is the gas estimate for a transaction that is willing to pay close to the median gas price
 	PrioritizedGasEstimate   uint64 `json:"prioritized_gas_estimate"`   // PrioritizedGasEstimate is the gas estimate for a transaction that is willing to pay more to be prioritized
 }
+
+// GasPriceTier chooses which of the gas estimates in [EstimateGasInfo] to use.  It can be passed as an option to
+// [NodeClient.BuildTransaction] to choose the gas unit price based on urgency.
+type GasPriceTier uint8
+
+const (
+	GasPriceTierNormal        GasPriceTier = iota // GasPriceTierNormal uses [EstimateGasInfo.GasEstimate], the default
+	GasPriceTierDeprioritized                     // GasPriceTierDeprioritized uses [EstimateGasInfo.DeprioritizedGasEstimate], which is cheaper but may be slower
+	GasPriceTierPrioritized                       // GasPriceTierPrioritized uses [EstimateGasInfo.PrioritizedGasEstimate], which costs more to be included sooner
+)
+
+// Price returns the gas unit price estimate for the tier.  If the node didn't provide an estimate for the tier, the
+// normal estimate is used.
+func (info EstimateGasInfo) Price(tier GasPriceTier) uint64 {
+	var price uint64
+	switch tier {
+	case GasPriceTierDeprioritized:
+		price = info.DeprioritizedGasEstimate
+	case GasPriceTierPrioritized:
+		price = info.PrioritizedGasEstimate
+	}
+	if price == 0 {
+		return info.GasEstimate
+	}
+	return price
+}
+
+// estimateGasInfoFromBCS decodes the BCS response of the gas estimate endpoint, where the deprioritized and prioritized
+// estimates are optional.  A missing estimate is left as 0, same as JSON.
+func estimateGasInfoFromBCS(data []byte) (info EstimateGasInfo, err error) {
+	des := bcs.NewDeserializer(data)
+	readU64 := func(des *bcs.Deserializer, out *uint64) {
+		*out = des.U64()
+	}
+	if deprioritized := bcs.DeserializeOption(des, readU64); deprioritized != nil {
+		info.DeprioritizedGasEstimate = *deprioritized
+	}
+	info.GasEstimate = des.U64()
+	if prioritized := bcs.DeserializeOption(des, readU64); prioritized != nil {
+		info.PrioritizedGasEstimate = *prioritized
+	}
+	if err = des.Error(); err != nil {
+		return info, err
+	}
+	if des.Remaining() > 0 {
+		return info, fmt.Errorf("gas estimate has %d remaining byte(s)", des.Remaining())
+	}
+	return info, nil
+}
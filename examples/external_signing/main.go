@@ -2,6 +2,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/aptos-labs/aptos-go-sdk"
 	"github.com/aptos-labs/aptos-go-sdk/crypto"
@@ -91,7 +92,7 @@ func example(networkConfig aptos.NetworkConfig) {
 	}
 
 	// Fund the sender with the faucet to create it on-chain
-	err = client.Fund(sender.Address, 100_000_000)
+	_, err = client.Fund(sender.Address, 100_000_000)
 	fmt.Printf("We fund the signer account %s with the faucet\n", sender.Address.String())
 
 	// Prep arguments
@@ -130,14 +131,24 @@ func example(networkConfig aptos.NetworkConfig) {
 		panic("Failed to sign message:" + err.Error())
 	}
 
+	// The authenticator can be sent back over a wire as JSON
+	authJson, err := json.Marshal(auth)
+	if err != nil {
+		panic("Failed to encode authenticator:" + err.Error())
+	}
+	fmt.Printf("Authenticator JSON: %s\n", authJson)
+	receivedAuth := &crypto.AccountAuthenticator{}
+	err = json.Unmarshal(authJson, receivedAuth)
+	if err != nil {
+		panic("Failed to decode authenticator:" + err.Error())
+	}
+
 	// Build a signed transaction
-	signedTxn, err := rawTxn.SignedTransactionWithAuthenticator(auth)
+	signedTxn, err := rawTxn.SignedTransactionWithAuthenticator(receivedAuth)
 	if err != nil {
 		panic("Failed to convert transaction authenticator:" + err.Error())
 	}
 
-	// TODO: Show how to send over a wire with an encoding
-
 	// Submit and wait for it to complete
 	submitResult, err := client.SubmitTransaction(signedTxn)
 	if err != nil {
@@ -38,7 +38,7 @@ func example(networkConfig aptos.NetworkConfig) {
 
 	// Fund the sender with the faucet to create it on-chain
 	println("SENDER: ", sender.Address.String())
-	err = client.Fund(sender.Address, FundAmount)
+	_, err = client.Fund(sender.Address, FundAmount)
 	if err != nil {
 		panic("Failed to fund sender:" + err.Error())
 	}
@@ -176,9 +176,9 @@ func runScript(client *aptos.Client, alice *aptos.Account, bob *aptos.AccountAdd
 			Code:     scriptBytes,
 			ArgTypes: []aptos.TypeTag{},
 			Args: []aptos.ScriptArgument{
-				{Variant: aptos.ScriptArgumentAddress, Value: *faMetadataAddress},
-				{Variant: aptos.ScriptArgumentAddress, Value: *bob},
-				{Variant: aptos.ScriptArgumentU64, Value: TransferAmount},
+				aptos.ScriptArgAddress(*faMetadataAddress),
+				aptos.ScriptArgAddress(*bob),
+				aptos.ScriptArgU64(TransferAmount),
 			},
 		}},
 	)
@@ -198,7 +198,7 @@ func runScript(client *aptos.Client, alice *aptos.Account, bob *aptos.AccountAdd
 	fmt.Printf("\n=== Simulation ===\n")
 	fmt.Printf("Gas unit price: %d\n", simulationResult[0].GasUnitPrice)
 	fmt.Printf("Gas used: %d\n", simulationResult[0].GasUsed)
-	fmt.Printf("Total gas fee: %d\n", simulationResult[0].GasUsed*simulationResult[0].GasUnitPrice)
+	fmt.Printf("Total gas fee: %d\n", simulationResult[0].Fee())
 	fmt.Printf("Status: %s\n", simulationResult[0].VmStatus)
 
 	// 3. Sign transaction
@@ -37,11 +37,11 @@ func example(networkConfig aptos.NetworkConfig) {
 	fmt.Printf("Bob:%s\n", bob.Address.String())
 
 	// Fund the sender with the faucet to create it on-chain
-	err = client.Fund(alice.Address, FundAmount)
+	_, err = client.Fund(alice.Address, FundAmount)
 	if err != nil {
 		panic("Failed to fund alice:" + err.Error())
 	}
-	err = client.Fund(bob.Address, FundAmount)
+	_, err = client.Fund(bob.Address, FundAmount)
 	if err != nil {
 		panic("Failed to fund bob:" + err.Error())
 	}
@@ -67,14 +67,8 @@ func example(networkConfig aptos.NetworkConfig) {
 			Code:     script,
 			ArgTypes: []aptos.TypeTag{aptos.AptosCoinTypeTag, aptos.AptosCoinTypeTag},
 			Args: []aptos.ScriptArgument{
-				{
-					Variant: aptos.ScriptArgumentU64,
-					Value:   uint64(TransferAmount),
-				},
-				{
-					Variant: aptos.ScriptArgumentU64,
-					Value:   uint64(TransferAmount + 200),
-				},
+				aptos.ScriptArgU64(TransferAmount),
+				aptos.ScriptArgU64(TransferAmount + 200),
 			},
 		}}, aptos.AdditionalSigners([]aptos.AccountAddress{bob.Address}))
 	if err != nil {
@@ -85,17 +79,16 @@ func example(networkConfig aptos.NetworkConfig) {
 	// This is useful for understanding how much the transaction will cost
 	// and to ensure that the transaction is valid before sending it to the network
 	// This is optional, but recommended
-	// TODO: Support simulate transaction with multi-agent / fee payer
-	/*simulationResult, err := client.SimulateTransaction(rawTxn, alice)
+	simulationResult, err := client.SimulateFeePayerTransaction(rawTxn, alice.PubKey(), nil, bob.PubKey())
 	if err != nil {
 		panic("Failed to simulate transaction:" + err.Error())
 	}
 	fmt.Printf("\n=== Simulation ===\n")
-	fmt.Printf("Gas unit price: %d\n", simulationResult[0].GasUnitPrice)
-	fmt.Printf("Gas used: %d\n", simulationResult[0].GasUsed)
-	fmt.Printf("Total gas fee: %d\n", simulationResult[0].GasUsed*simulationResult[0].GasUnitPrice)
-	fmt.Printf("Status: %s\n", simulationResult[0].VmStatus)
-	*/
+	fmt.Printf("Gas unit price: %d\n", simulationResult.GasUnitPrice)
+	fmt.Printf("Gas used: %d\n", simulationResult.GasUsed)
+	fmt.Printf("Total gas fee: %d\n", simulationResult.Fee())
+	fmt.Printf("Status: %s\n", simulationResult.VmStatus)
+
 	// 3. Sign transaction with both parties separately, this would be on different machines or places
 	aliceAuth, err := rawTxn.Sign(alice)
 	if err != nil {
@@ -78,6 +78,7 @@ type MultiKeySigner struct {
 	Keystore           KeyStore
 	PublicKey          *crypto.MultiKey
 	SignaturesRequired uint8
+	SignerIndices      []uint8 // The keys to sign with, see UseSigners, or the first SignaturesRequired keys if empty
 }
 
 func NewMultiKeySigner(keystore KeyStore, signaturesRequired uint8) (*MultiKeySigner, error) {
@@ -102,10 +103,19 @@ func NewMultiKeySigner(keystore KeyStore, signaturesRequired uint8) (*MultiKeySi
 	}, nil
 }
 
+// UseSigners signs with a subset of the available keys, e.g. when some keys are offline.  The available keys are in
+// order of preference.
+func (s *MultiKeySigner) UseSigners(available []uint8) error {
+	indices, err := s.PublicKey.SelectSigners(available)
+	if err != nil {
+		return err
+	}
+	s.SignerIndices = indices
+	return nil
+}
+
 func (s *MultiKeySigner) AccountAddress() aptos.AccountAddress {
-	address := aptos.AccountAddress{}
-	address.FromAuthKey(s.AuthKey())
-	return address
+	return aptos.AddressFromPublicKey(s.PublicKey)
 }
 
 func (s *MultiKeySigner) Sign(msg []byte) (authenticator *crypto.AccountAuthenticator, err error) {
@@ -124,17 +134,21 @@ func (s *MultiKeySigner) Sign(msg []byte) (authenticator *crypto.AccountAuthenti
 }
 
 func (s *MultiKeySigner) SignMessage(msg []byte) (crypto.Signature, error) {
-	indexedSigs := make([]crypto.IndexedAnySignature, s.SignaturesRequired)
-
-	for i := uint8(0); i < s.SignaturesRequired; i++ {
-		sig, err := s.Keystore.SignMessage(i, msg)
-		if err != nil {
-			return nil, err
+	indices := s.SignerIndices
+	if len(indices) == 0 {
+		indices = make([]uint8, s.SignaturesRequired)
+		for i := range indices {
+			indices[i] = uint8(i)
 		}
+	}
+	indexedSigs := make([]crypto.IndexedAnySignature, len(indices))
+
+	for i, index := range indices {
+		sig, err := s.Keystore.SignMessage(index, msg)
 		if err != nil {
 			return nil, err
 		}
-		indexedSigs[i] = crypto.IndexedAnySignature{Signature: sig.(*crypto.AnySignature), Index: i}
+		indexedSigs[i] = crypto.IndexedAnySignature{Signature: sig.(*crypto.AnySignature), Index: index}
 	}
 
 	return crypto.NewMultiKeySignature(indexedSigs)
@@ -181,13 +195,18 @@ func example(networkConfig aptos.NetworkConfig) {
 	if err != nil {
 		panic("Failed to create multi key signer:" + err.Error())
 	}
+	// Key 0 is unavailable, so sign with two of the others
+	err = multikeySigner.UseSigners([]uint8{3, 1, 2})
+	if err != nil {
+		panic("Failed to select signers:" + err.Error())
+	}
 
 	// Fund the sender with the faucet to create it on-chain
-	err = client.Fund(alice.AccountAddress(), TransferAmount)
+	_, err = client.Fund(alice.AccountAddress(), TransferAmount)
 	if err != nil {
 		panic("Failed to fund alice:" + err.Error())
 	}
-	err = client.Fund(multikeySigner.AccountAddress(), FundAmount)
+	_, err = client.Fund(multikeySigner.AccountAddress(), FundAmount)
 	if err != nil {
 		panic("Failed to fund multikey:" + err.Error())
 	}
@@ -247,7 +266,7 @@ func example(networkConfig aptos.NetworkConfig) {
 		fmt.Printf("\n=== Simulation ===\n")
 		fmt.Printf("Gas unit price: %d\n", simulationResult[0].GasUnitPrice)
 		fmt.Printf("Gas used: %d\n", simulationResult[0].GasUsed)
-		fmt.Printf("Total gas fee: %d\n", simulationResult[0].GasUsed*simulationResult[0].GasUnitPrice)
+		fmt.Printf("Total gas fee: %d\n", simulationResult[0].Fee())
 		fmt.Printf("Status: %s\n", simulationResult[0].VmStatus)
 	*/
 	// 3. Sign transaction
@@ -135,7 +135,7 @@ func generateOwnerAccounts() []*aptos.Account {
 
 func fundAccounts(client *aptos.Client, accounts []*aptos.AccountAddress) {
 	for _, account := range accounts {
-		err := client.Fund(*account, 100_000_000)
+		_, err := client.Fund(*account, 100_000_000)
 		if err != nil {
 			panic("Failed to fund account " + err.Error())
 		}
@@ -154,6 +154,19 @@ func setUpMultisig(client *aptos.Client, accounts []*aptos.Account) *aptos.Accou
 		panic("Failed to fetch next multisig address: " + err.Error())
 	}
 
+	// The address can also be predicted locally from the creator's sequence number
+	creatorInfo, err := client.Account(accounts[0].Address)
+	if err != nil {
+		panic("Failed to fetch creator account: " + err.Error())
+	}
+	creatorSequenceNumber, err := creatorInfo.SequenceNumber()
+	if err != nil {
+		panic("Failed to parse creator sequence number: " + err.Error())
+	}
+	if predicted := aptos.PredictMultisigAddress(accounts[0].Address, creatorSequenceNumber); predicted != *multisigAddress {
+		panic("Predicted multisig address " + predicted.String() + " doesn't match " + multisigAddress.String())
+	}
+
 	// Create the multisig account with 3 owners and a signature threshold of 2.
 	createMultisig(client, accounts[0], []aptos.AccountAddress{accounts[1].Address, accounts[2].Address})
 	println("Multisig Account Address:", multisigAddress.String())
@@ -169,12 +182,7 @@ func setUpMultisig(client *aptos.Client, accounts []*aptos.Account) *aptos.Accou
 }
 
 func createMultisig(client *aptos.Client, account *aptos.Account, additionalAddresses []aptos.AccountAddress) {
-	// TODO: Ideally, this would not be done, and the payload function would take an array of items to serialize
-	metadataValue, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
-		bcs.SerializeSequenceWithFunction([]string{"example"}, ser, func(ser *bcs.Serializer, item string) {
-			ser.WriteString(item)
-		})
-	})
+	metadataValue, err := bcs.SerializeSequenceOf([]string{"example"}, (*bcs.Serializer).WriteString)
 	if err != nil {
 		panic("Failed to serialize metadata value" + err.Error())
 	}
@@ -191,24 +199,18 @@ func createMultisig(client *aptos.Client, account *aptos.Account, additionalAddr
 	submitAndWait(client, account, payload)
 }
 
-// TODO: This should be a view function
-func multisigResource(client *aptos.Client, multisigAddress *aptos.AccountAddress) (uint64, []any) {
-	resource, err := client.AccountResource(*multisigAddress, "0x1::multisig_account::MultisigAccount")
+// multisigAccount is the subset of the 0x1::multisig_account::MultisigAccount resource used by this example
+func multisigResource(client *aptos.Client, multisigAddress *aptos.AccountAddress) (uint64, []aptos.AccountAddress) {
+	numSigsRequired, err := client.MultisigSignaturesRequired(*multisigAddress)
 	if err != nil {
-		panic("Failed to get resource for multisig account: " + err.Error())
+		panic("Failed to get signatures required for multisig account: " + err.Error())
 	}
-	// TODO: Add JSON types
-	resourceData := resource["data"].(map[string]any)
-
-	numSigsRequiredStr := resourceData["num_signatures_required"].(string)
-
-	numSigsRequired, err := aptos.StrToUint64(numSigsRequiredStr)
+	owners, err := client.MultisigOwners(*multisigAddress)
 	if err != nil {
-		panic("Failed to convert string to u64: " + err.Error())
+		panic("Failed to get owners for multisig account: " + err.Error())
 	}
-	ownersArray := resourceData["owners"].([]any)
 
-	return numSigsRequired, ownersArray
+	return numSigsRequired, owners
 }
 
 func createMultisigTransferTransaction(client *aptos.Client, sender *aptos.Account, multisigAddress aptos.AccountAddress, recipient aptos.AccountAddress) *aptos.MultisigTransactionPayload {
@@ -227,7 +229,7 @@ func createMultisigTransferTransaction(client *aptos.Client, sender *aptos.Accou
 		panic("Failed to create payload to create transaction for multisig transfer: " + err.Error())
 	}
 
-	// TODO: add simulation of multisig payload
+	simulateMultisigPayload(client, sender, multisigAddress, multisigPayload)
 	submitAndWait(client, sender, createTransactionPayload)
 	return multisigPayload
 }
@@ -271,11 +273,23 @@ func createTransactionPayloadCommon(client *aptos.Client, sender *aptos.Account,
 		panic("Failed to create payload to create transaction for multisig: " + err.Error())
 	}
 
-	// TODO: add simulation of multisig payload
+	simulateMultisigPayload(client, sender, multisigAddress, multisigPayload)
 	submitAndWait(client, sender, createTransactionPayload)
 	return multisigPayload
 }
 
+// simulateMultisigPayload checks that the payload will succeed before creating the multisig transaction on-chain
+func simulateMultisigPayload(client *aptos.Client, sender *aptos.Account, multisigAddress aptos.AccountAddress, payload *aptos.MultisigTransactionPayload) {
+	simulation, err := client.SimulateMultisigPayload(sender, multisigAddress, payload)
+	if err != nil {
+		panic("Failed to simulate multisig payload: " + err.Error())
+	}
+	if !simulation.Success {
+		panic("Multisig payload simulation failed: " + simulation.VmStatus)
+	}
+	fmt.Printf("Simulated multisig payload, gas used: %d\n", simulation.GasUsed)
+}
+
 func rejectAndApprove(client *aptos.Client, multisigAddress aptos.AccountAddress, rejector *aptos.Account, approver *aptos.Account, transactionId uint64) {
 	rejectPayload, err := aptos.MultisigRejectPayload(multisigAddress, transactionId)
 	if err != nil {
@@ -315,12 +329,9 @@ func submitAndWait(client *aptos.Client, sender *aptos.Account, payload aptos.Tr
 	}
 
 	// Now check that there's no event for failed multisig
-	// TODO: make this a function on the user transaction
-	for _, event := range txn.Events {
-		if event.Type == "0x1::multisig_account::TransactionExecutionFailed" {
-			eventStr, _ := json.Marshal(event)
-			panic(fmt.Sprintf("Multisig transaction failed. details: %s", eventStr))
-		}
+	if event, ok := txn.FindEvent("0x1::multisig_account::TransactionExecutionFailed"); ok {
+		eventStr, _ := json.Marshal(event)
+		panic(fmt.Sprintf("Multisig transaction failed. details: %s", eventStr))
 	}
 
 	return txn
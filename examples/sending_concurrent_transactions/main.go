@@ -18,7 +18,7 @@ func setup(networkConfig aptos.NetworkConfig) (*aptos.Client, aptos.TransactionS
 		panic("Failed to create sender:" + err.Error())
 	}
 
-	err = client.Fund(sender.Address, 100_000_000)
+	_, err = client.Fund(sender.Address, 100_000_000)
 	if err != nil {
 		panic("Failed to fund sender:" + err.Error())
 	}
@@ -47,8 +47,12 @@ func sendManyTransactionsSerially(networkConfig aptos.NetworkConfig, numTransact
 	payload := payload()
 
 	senderAddress := sender.AccountAddress()
-	sequenceNumber := uint64(0)
+	sequenceManager := client.SequenceManagerFor(senderAddress)
 	for i := uint64(0); i < numTransactions; i++ {
+		sequenceNumber, err := sequenceManager.Next()
+		if err != nil {
+			panic("Failed to get sequence number:" + err.Error())
+		}
 		rawTxn, err := client.BuildTransaction(senderAddress, payload, aptos.SequenceNumber(sequenceNumber))
 		if err != nil {
 			panic("Failed to build transaction:" + err.Error())
@@ -64,7 +68,6 @@ func sendManyTransactionsSerially(networkConfig aptos.NetworkConfig, numTransact
 			panic("Failed to submit transaction:" + err.Error())
 		}
 		responses[i] = submitResult
-		sequenceNumber++
 	}
 
 	// Wait on last transaction
@@ -39,13 +39,13 @@ func example(networkConfig aptos.NetworkConfig) {
 	fmt.Printf("Sponsor:%s\n", sponsor.Address.String())
 
 	// Fund the alice with the faucet to create it on-chain
-	err = client.Fund(alice.Address, FundAmount)
+	_, err = client.Fund(alice.Address, FundAmount)
 	if err != nil {
 		panic("Failed to fund alice:" + err.Error())
 	}
 
 	// And the sponsor
-	err = client.Fund(sponsor.Address, FundAmount)
+	_, err = client.Fund(sponsor.Address, FundAmount)
 	if err != nil {
 		panic("Failed to fund sponsor:" + err.Error())
 	}
@@ -34,7 +34,7 @@ func example(networkConfig aptos.NetworkConfig) {
 	fmt.Printf("Bob:%s\n", bob.Address.String())
 
 	// Fund the sender with the faucet to create it on-chain
-	err = client.Fund(alice.Address, FundAmount)
+	_, err = client.Fund(alice.Address, FundAmount)
 	if err != nil {
 		panic("Failed to fund alice:" + err.Error())
 	}
@@ -91,7 +91,7 @@ func example(networkConfig aptos.NetworkConfig) {
 	fmt.Printf("\n=== Simulation ===\n")
 	fmt.Printf("Gas unit price: %d\n", simulationResult[0].GasUnitPrice)
 	fmt.Printf("Gas used: %d\n", simulationResult[0].GasUsed)
-	fmt.Printf("Total gas fee: %d\n", simulationResult[0].GasUsed*simulationResult[0].GasUnitPrice)
+	fmt.Printf("Total gas fee: %d\n", simulationResult[0].Fee())
 	fmt.Printf("Status: %s\n", simulationResult[0].VmStatus)
 
 	// 3. Sign transaction
@@ -0,0 +1,225 @@
+package aptos
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultFailoverRecoveryPeriod is how long a failover client waits before trying the primary node again
+const DefaultFailoverRecoveryPeriod = 30 * time.Second
+
+// FailoverRecoveryPeriod is an option to [NewFailoverClient], for how long to wait after failing over before trying the
+// primary node again
+type FailoverRecoveryPeriod time.Duration
+
+// NewFailoverClient creates a [Client] that fails over between multiple nodes on the same network.  Requests go to the
+// first config's node, and move to the next node on connection errors or 5xx responses.  After failing over, the
+// primary node is tried again every [FailoverRecoveryPeriod].
+//
+// Reads are retried on the next node.  Transaction submissions are never retried, as the transaction may have been
+// submitted even if the response failed; later requests still move to the next node.  The indexer and faucet of the
+// first config are used.
+//
+// Options can be a [*http.Client] or [FailoverRecoveryPeriod]
+//
+//	client, err := NewFailoverClient([]NetworkConfig{primaryConfig, MainnetConfig})
+func NewFailoverClient(configs []NetworkConfig, options ...any) (*Client, error) {
+	if len(configs) == 0 {
+		return nil, errors.New("NewFailoverClient requires at least one NetworkConfig")
+	}
+	var httpClient *http.Client
+	recoveryPeriod := DefaultFailoverRecoveryPeriod
+	for i, arg := range options {
+		switch value := arg.(type) {
+		case *http.Client:
+			if httpClient != nil {
+				return nil, fmt.Errorf("NewFailoverClient only accepts one http.Client")
+			}
+			httpClient = value
+		case FailoverRecoveryPeriod:
+			recoveryPeriod = time.Duration(value)
+		default:
+			return nil, fmt.Errorf("NewFailoverClient arg %d bad type %T", i+1, arg)
+		}
+	}
+
+	chainId := configs[0].ChainId
+	nodeUrls := make([]*url.URL, len(configs))
+	for i, config := range configs {
+		if config.ChainId != 0 {
+			if chainId == 0 {
+				chainId = config.ChainId
+			} else if config.ChainId != chainId {
+				return nil, &ChainMismatchError{ExpectedChainId: chainId, ActualChainId: config.ChainId}
+			}
+		}
+		nodeUrl, err := url.Parse(config.NodeUrl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RPC url '%s': %w", config.NodeUrl, err)
+		}
+		nodeUrls[i] = nodeUrl
+	}
+
+	if httpClient == nil {
+		// Match the defaults of NewNodeClient
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+		httpClient = &http.Client{
+			Jar:     jar,
+			Timeout: 60 * time.Second,
+		}
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	failoverHttpClient := *httpClient
+	failoverHttpClient.Transport = &failoverTransport{
+		inner:          transport,
+		nodeUrls:       nodeUrls,
+		recoveryPeriod: recoveryPeriod,
+	}
+
+	primaryConfig := configs[0]
+	primaryConfig.ChainId = chainId
+	return NewClient(primaryConfig, &failoverHttpClient)
+}
+
+// failoverTransport is an [http.RoundTripper] that sends requests for the primary node to the current healthy node
+type failoverTransport struct {
+	inner          http.RoundTripper
+	nodeUrls       []*url.URL // nodeUrls are the base URLs of each node, the first being the primary
+	recoveryPeriod time.Duration
+
+	lock       sync.Mutex
+	current    int       // current is the index of the node requests are sent to
+	failedOver time.Time // failedOver is when the primary last failed
+}
+
+// innerTransport returns the wrapped transport
+func (ft *failoverTransport) innerTransport() http.RoundTripper {
+	return ft.inner
+}
+
+// setInnerTransport replaces the wrapped transport
+func (ft *failoverTransport) setInnerTransport(inner http.RoundTripper) {
+	ft.inner = inner
+}
+
+// RoundTrip sends the request to the current node, failing over to the next node if it fails
+//
+// Implements:
+//   - [http.RoundTripper]
+func (ft *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	suffix, ok := ft.pathSuffix(req.URL)
+	if !ok {
+		// Not a node request, e.g. the indexer or faucet
+		return ft.inner.RoundTrip(req)
+	}
+
+	retryable := !isSubmitRequest(req, suffix) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+	start := ft.startNode()
+	var response *http.Response
+	var err error
+	for attempt := range len(ft.nodeUrls) {
+		node := (start + attempt) % len(ft.nodeUrls)
+		attemptReq := req.Clone(req.Context())
+		attemptReq.URL = ft.nodeUrl(node, req.URL, suffix)
+		attemptReq.Host = ""
+		if attempt > 0 && req.GetBody != nil {
+			attemptReq.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		response, err = ft.inner.RoundTrip(attemptReq)
+		if err == nil && response.StatusCode < http.StatusInternalServerError {
+			ft.succeeded(node)
+			return response, nil
+		}
+		ft.failed(node)
+		if !retryable || attempt == len(ft.nodeUrls)-1 || req.Context().Err() != nil {
+			break
+		}
+		if err == nil {
+			_ = response.Body.Close()
+		}
+	}
+	return response, err
+}
+
+// pathSuffix returns the path of the request after the primary node's base path, if it is a request to the primary
+func (ft *failoverTransport) pathSuffix(requestUrl *url.URL) (string, bool) {
+	primary := ft.nodeUrls[0]
+	if requestUrl.Scheme != primary.Scheme || requestUrl.Host != primary.Host {
+		return "", false
+	}
+	basePath := strings.TrimSuffix(primary.Path, "/")
+	if !strings.HasPrefix(requestUrl.Path, basePath) {
+		return "", false
+	}
+	return strings.TrimPrefix(requestUrl.Path, basePath), true
+}
+
+// nodeUrl rewrites the request URL to be for the given node
+func (ft *failoverTransport) nodeUrl(node int, requestUrl *url.URL, suffix string) *url.URL {
+	nodeUrl := *requestUrl
+	base := ft.nodeUrls[node]
+	nodeUrl.Scheme = base.Scheme
+	nodeUrl.Host = base.Host
+	nodeUrl.User = base.User
+	nodeUrl.Path = strings.TrimSuffix(base.Path, "/") + suffix
+	nodeUrl.RawPath = ""
+	return &nodeUrl
+}
+
+// startNode returns the node to send the next request to, which is the primary if it is time to try it again
+func (ft *failoverTransport) startNode() int {
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+	if ft.current != 0 && time.Since(ft.failedOver) >= ft.recoveryPeriod {
+		return 0
+	}
+	return ft.current
+}
+
+// succeeded records that the node is healthy
+func (ft *failoverTransport) succeeded(node int) {
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+	ft.current = node
+}
+
+// failed records that the node is unhealthy, and moves to the next node if it is the current node
+func (ft *failoverTransport) failed(node int) {
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+	if node == 0 {
+		ft.failedOver = time.Now()
+	}
+	if node == ft.current {
+		ft.current = (node + 1) % len(ft.nodeUrls)
+	}
+}
+
+// isSubmitRequest checks if the request submits transactions, which must not be retried
+func isSubmitRequest(req *http.Request, suffix string) bool {
+	if req.Method != http.MethodPost {
+		return false
+	}
+	switch strings.TrimSuffix(suffix, "/") {
+	case "/transactions", "/transactions/batch":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+package aptos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const failoverTestNodeInfo = `{"chain_id":4,"epoch":"1","ledger_version":"10","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`
+
+func TestFailoverClient(t *testing.T) {
+	primaryHealthy := atomic.Bool{}
+	primaryRequests := atomic.Int32{}
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryRequests.Add(1)
+		if !primaryHealthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(failoverTestNodeInfo))
+	}))
+	defer primary.Close()
+	fallbackRequests := atomic.Int32{}
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackRequests.Add(1)
+		assert.Equal(t, "/v1", r.URL.Path)
+		_, _ = w.Write([]byte(failoverTestNodeInfo))
+	}))
+	defer fallback.Close()
+
+	client, err := NewFailoverClient([]NetworkConfig{
+		{NodeUrl: primary.URL + "/v1", ChainId: 4},
+		{NodeUrl: fallback.URL + "/v1/"},
+	}, FailoverRecoveryPeriod(time.Hour))
+	assert.NoError(t, err)
+
+	// Reads fail over to the fallback
+	_, err = client.Info()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), primaryRequests.Load())
+	assert.Equal(t, int32(1), fallbackRequests.Load())
+
+	// Later requests go directly to the fallback
+	_, err = client.Info()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), primaryRequests.Load())
+	assert.Equal(t, int32(2), fallbackRequests.Load())
+
+	// The primary is tried again after the recovery period
+	client.nodeClient.client.Transport.(*failoverTransport).recoveryPeriod = 0
+	primaryHealthy.Store(true)
+	_, err = client.Info()
+	assert.NoError(t, err)
+	_, err = client.Info()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), primaryRequests.Load())
+	assert.Equal(t, int32(2), fallbackRequests.Load())
+
+	// Configs on different networks are rejected
+	_, err = NewFailoverClient([]NetworkConfig{{NodeUrl: primary.URL, ChainId: 1}, {NodeUrl: fallback.URL, ChainId: 2}})
+	assert.ErrorIs(t, err, ErrChainMismatch)
+}
+
+func TestFailoverClientSubmitNotRetried(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+	fallbackRequests := atomic.Int32{}
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackRequests.Add(1)
+		_, _ = w.Write([]byte(`{"hash":"0x1","sender":"0x1","sequence_number":"0","max_gas_amount":"1","gas_unit_price":"1","expiration_timestamp_secs":"1","payload":{"type":"entry_function_payload","function":"0x1::m::f","type_arguments":[],"arguments":[]}}`))
+	}))
+	defer fallback.Close()
+
+	client, err := NewFailoverClient([]NetworkConfig{{NodeUrl: primary.URL, ChainId: 4}, {NodeUrl: fallback.URL}})
+	assert.NoError(t, err)
+
+	signedTxnBytes := make([]byte, 10)
+	_, err = client.SubmitTransactionBCS(signedTxnBytes)
+	assert.Error(t, err)
+	assert.Equal(t, int32(0), fallbackRequests.Load())
+
+	// The next submission goes to the fallback
+	_, err = client.SubmitTransactionBCS(signedTxnBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), fallbackRequests.Load())
+}
@@ -8,29 +8,59 @@ import (
 	"strconv"
 )
 
+// FaucetAuthToken is an option for [NewFaucetClient] and [Client.WithFaucet], which sets a bearer token to
+// authenticate with the faucet, e.g. to skip rate limits on a faucet that requires an API key
+type FaucetAuthToken string
+
+// FaucetHeader is an option for [NewFaucetClient] and [Client.WithFaucet], which sets an arbitrary header on every
+// faucet request
+type FaucetHeader struct {
+	Key   string
+	Value string
+}
+
 // FaucetClient uses the underlying NodeClient to request for APT for gas on a network.
 // This can only be used in a test network (e.g. Localnet, Devnet, Testnet)
 type FaucetClient struct {
-	nodeClient *NodeClient // NodeClient to use for requesting funds
-	url        *url.URL    // URL of the faucet e.g. https://testnet.faucet.aptoslabs.com
+	nodeClient *NodeClient       // NodeClient to use for requesting funds
+	url        *url.URL          // URL of the faucet e.g. https://testnet.faucet.aptoslabs.com
+	headers    map[string]string // Headers to set on faucet requests, in addition to the node client's headers
 }
 
 // NewFaucetClient creates a new client specifically for requesting faucet funds
-func NewFaucetClient(nodeClient *NodeClient, faucetUrl string) (*FaucetClient, error) {
+//
+// Options can be [FaucetAuthToken] or [FaucetHeader]
+//
+//	faucetClient, err := NewFaucetClient(nodeClient, "http://127.0.0.1:8081", FaucetAuthToken("my-token"))
+func NewFaucetClient(nodeClient *NodeClient, faucetUrl string, options ...any) (*FaucetClient, error) {
 	parsedUrl, err := url.Parse(faucetUrl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse faucet url '%s': %w", faucetUrl, err)
 	}
+	headers := make(map[string]string)
+	for i, arg := range options {
+		switch value := arg.(type) {
+		case FaucetAuthToken:
+			headers["Authorization"] = "Bearer " + string(value)
+		case FaucetHeader:
+			headers[value.Key] = value.Value
+		default:
+			return nil, fmt.Errorf("NewFaucetClient arg [%d] unknown option type %T", i+1, arg)
+		}
+	}
 	return &FaucetClient{
 		nodeClient,
 		parsedUrl,
+		headers,
 	}, nil
 }
 
-// Fund account with the given amount of AptosCoin
-func (faucetClient *FaucetClient) Fund(address AccountAddress, amount uint64) error {
+// Fund account with the given amount of AptosCoin, and waits for the funding transactions to complete.
+//
+// Returns the hashes of the transactions minted by the faucet.
+func (faucetClient *FaucetClient) Fund(address AccountAddress, amount uint64) ([]string, error) {
 	if faucetClient.nodeClient == nil {
-		return errors.New("faucet's node-client not initialized")
+		return nil, errors.New("faucet's node-client not initialized")
 	}
 
 	// Build URL
@@ -41,17 +71,20 @@ func (faucetClient *FaucetClient) Fund(address AccountAddress, amount uint64) er
 	mintUrl.RawQuery = params.Encode()
 
 	// Make request for funds
-	txnHashes, err := Post[[]string](faucetClient.nodeClient, mintUrl.String(), "text/plain", nil)
+	txnHashes, err := postWithHeaders[[]string](faucetClient.nodeClient, mintUrl.String(), "text/plain", nil, faucetClient.headers)
 	if err != nil {
-		return fmt.Errorf("response api decode error, %w", err)
+		return nil, fmt.Errorf("response api decode error, %w", err)
 	}
 
 	// Wait for fund transactions to go through
 	slog.Debug("FundAccount wait for transactions", "number of transactions", len(txnHashes))
 	if len(txnHashes) == 1 {
 		_, err = faucetClient.nodeClient.WaitForTransaction(txnHashes[0])
-		return err
 	} else {
-		return faucetClient.nodeClient.PollForTransactions(txnHashes)
+		err = faucetClient.nodeClient.PollForTransactions(txnHashes)
+	}
+	if err != nil {
+		return nil, err
 	}
+	return txnHashes, nil
 }
@@ -0,0 +1,43 @@
+package aptos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFaucetFund(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/faucet/mint":
+			assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+			assert.Equal(t, "ci", r.Header.Get("X-Api-Key"))
+			assert.Equal(t, "100", r.URL.Query().Get("amount"))
+			_, _ = w.Write([]byte(`["0xabcd"]`))
+		case "/transactions/by_hash/0xabcd":
+			_, _ = w.Write([]byte(`{"type":"user_transaction","version":"10","hash":"0xabcd","success":true,"vm_status":"Executed successfully","sender":"0x1","sequence_number":"0","changes":[],"events":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(NetworkConfig{NodeUrl: server.URL, ChainId: 4})
+	assert.NoError(t, err)
+
+	// No faucet configured
+	_, err = client.Fund(AccountOne, 100)
+	assert.Error(t, err)
+
+	client, err = client.WithFaucet(server.URL+"/faucet", FaucetAuthToken("my-token"), FaucetHeader{Key: "X-Api-Key", Value: "ci"})
+	assert.NoError(t, err)
+
+	hashes, err := client.Fund(AccountOne, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0xabcd"}, hashes)
+
+	_, err = client.WithFaucet(server.URL, "bad option")
+	assert.Error(t, err)
+}
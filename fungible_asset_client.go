@@ -1,7 +1,9 @@
 package aptos
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"strconv"
 )
@@ -50,7 +52,7 @@ func (client *FungibleAssetClient) Transfer(sender TransactionSigner, senderStor
 // TransferPrimaryStore sends amount of the fungible asset from the primary store of the sender to receiverAddress
 func (client *FungibleAssetClient) TransferPrimaryStore(sender TransactionSigner, receiverAddress AccountAddress, amount uint64) (signedTxn *SignedTransaction, err error) {
 	// Build transaction
-	payload, err := FungibleAssetPrimaryStoreTransferPayload(client.metadataAddress, receiverAddress, amount)
+	payload, err := client.TransferPrimaryStorePayload(receiverAddress, amount)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +65,13 @@ func (client *FungibleAssetClient) TransferPrimaryStore(sender TransactionSigner
 	return rawTxn.SignedTransaction(sender)
 }
 
+// TransferPrimaryStorePayload builds the payload to send amount of the fungible asset from the primary store of the
+// transaction sender to receiverAddress, without submitting it.  This allows the transfer to be used in fee payer,
+// multi-agent, and multisig transactions.
+func (client *FungibleAssetClient) TransferPrimaryStorePayload(receiverAddress AccountAddress, amount uint64) (payload *EntryFunction, err error) {
+	return FungibleAssetPrimaryStoreTransferPayload(client.metadataAddress, receiverAddress, amount)
+}
+
 // -- View functions -- //
 
 // PrimaryStoreAddress returns the [AccountAddress] of the primary store for the owner
@@ -99,6 +108,46 @@ func (client *FungibleAssetClient) PrimaryBalance(owner *AccountAddress, ledgerV
 	return StrToUint64(balanceStr)
 }
 
+// PrimaryBalances returns the balances of the primary stores of the owner for multiple fungible assets, keyed by
+// metadata address.  The view calls are made concurrently with [Client.ViewBatch].
+//
+// Assets where the owner has no primary store are included with a balance of 0.  If any balance fails, the first error
+// is returned.
+func (client *FungibleAssetClient) PrimaryBalances(ctx context.Context, owner AccountAddress, metadata []AccountAddress, ledgerVersion ...uint64) (balances map[AccountAddress]uint64, err error) {
+	payloads := make([]*ViewPayload, len(metadata))
+	for i, metadataAddress := range metadata {
+		payloads[i] = &ViewPayload{
+			Module: ModuleId{
+				Address: AccountOne,
+				Name:    "primary_fungible_store",
+			},
+			Function: "balance",
+			ArgTypes: []TypeTag{metadataStructTag()},
+			Args:     [][]byte{owner[:], metadataAddress[:]},
+		}
+	}
+
+	results, errs := client.aptosClient.ViewBatch(ctx, payloads, ledgerVersion...)
+	balances = make(map[AccountAddress]uint64, len(metadata))
+	for i, metadataAddress := range metadata {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to get balance for %s: %w", metadataAddress.String(), errs[i])
+		}
+		if len(results[i]) == 0 {
+			return nil, fmt.Errorf("bad view return from node, no balance for %s", metadataAddress.String())
+		}
+		balanceStr, ok := results[i][0].(string)
+		if !ok {
+			return nil, fmt.Errorf("bad view return from node, balance for %s is not a string", metadataAddress.String())
+		}
+		balances[metadataAddress], err = StrToUint64(balanceStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return balances, nil
+}
+
 // PrimaryIsFrozen returns true if the primary store for the owner is frozen
 func (client *FungibleAssetClient) PrimaryIsFrozen(owner *AccountAddress, ledgerVersion ...uint64) (isFrozen bool, err error) {
 	val, err := client.viewPrimaryStore([][]byte{owner[:], client.metadataAddress[:]}, "is_frozen", ledgerVersion...)
@@ -208,7 +257,7 @@ func (client *FungibleAssetClient) Decimals() (decimals uint8, err error) {
 }
 
 // IconUri returns the URI of the icon for the fungible asset
-func (client *FungibleAssetClient) IconUri(uri string, err error) {
+func (client *FungibleAssetClient) IconUri() (uri string, err error) {
 	val, err := client.viewMetadata([][]byte{client.metadataAddress[:]}, "icon_uri")
 	if err != nil {
 		return
@@ -218,7 +267,7 @@ func (client *FungibleAssetClient) IconUri(uri string, err error) {
 }
 
 // ProjectUri returns the URI of the project for the fungible asset
-func (client *FungibleAssetClient) ProjectUri(uri string, err error) {
+func (client *FungibleAssetClient) ProjectUri() (uri string, err error) {
 	val, err := client.viewMetadata([][]byte{client.metadataAddress[:]}, "project_uri")
 	if err != nil {
 		return
@@ -227,6 +276,20 @@ func (client *FungibleAssetClient) ProjectUri(uri string, err error) {
 	return
 }
 
+// FungibleAssetMetadata is the display information of a fungible asset, from the 0x1::fungible_asset::Metadata resource
+type FungibleAssetMetadata struct {
+	Name       string `json:"name"`        // Name is the full name of the fungible asset e.g. Aptos Coin
+	Symbol     string `json:"symbol"`      // Symbol is the short symbol of the fungible asset e.g. APT
+	Decimals   uint8  `json:"decimals"`    // Decimals is the number of decimal places to display e.g. 8 for APT
+	IconURI    string `json:"icon_uri"`    // IconURI is the URI of the icon for the fungible asset, may be empty
+	ProjectURI string `json:"project_uri"` // ProjectURI is the URI of the project for the fungible asset, may be empty
+}
+
+// Metadata returns the name, symbol, decimals, and URIs of the fungible asset in a single request
+func (client *FungibleAssetClient) Metadata(ledgerVersion ...uint64) (metadata *FungibleAssetMetadata, err error) {
+	return AccountResourceInto[FungibleAssetMetadata](client.aptosClient, *client.metadataAddress, "0x1::fungible_asset::Metadata", ledgerVersion...)
+}
+
 // viewMetadata calls a view function on the fungible asset metadata
 func (client *FungibleAssetClient) viewMetadata(args [][]byte, functionName string, ledgerVersion ...uint64) (result any, err error) {
 	payload := &ViewPayload{
@@ -1,5 +1,16 @@
 package aptos
 
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
 /* TODO: Re-enable when running on localnet
 func TestClient(t *testing.T) {
 	if testing.Short() {
@@ -83,3 +94,75 @@ func TestClient(t *testing.T) {
 	assert.False(t, isFrozen
 
 }*/
+
+// newTestFungibleAssetClient creates a client against a fake node, which responds to view functions with handleView
+func newTestFungibleAssetClient(t *testing.T, handleView func(body []byte) string) *FungibleAssetClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		response := handleView(body)
+		if response == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(NetworkConfig{NodeUrl: server.URL, ChainId: 4})
+	assert.NoError(t, err)
+	return &FungibleAssetClient{aptosClient: client, metadataAddress: &AccountOne}
+}
+
+func TestFungibleAssetClient_PrimaryBalances(t *testing.T) {
+	metadataWithBalance := AccountAddress{0xAA}
+	metadataWithoutStore := AccountAddress{0xBB}
+	faClient := newTestFungibleAssetClient(t, func(body []byte) string {
+		if bytes.Contains(body, metadataWithBalance[:]) {
+			return `["1000"]`
+		}
+		return `["0"]`
+	})
+
+	balances, err := faClient.PrimaryBalances(context.Background(), AccountTwo, []AccountAddress{metadataWithBalance, metadataWithoutStore})
+	assert.NoError(t, err)
+	assert.Equal(t, map[AccountAddress]uint64{metadataWithBalance: 1000, metadataWithoutStore: 0}, balances)
+
+	faClient = newTestFungibleAssetClient(t, func(body []byte) string {
+		return ""
+	})
+	_, err = faClient.PrimaryBalances(context.Background(), AccountTwo, []AccountAddress{metadataWithBalance})
+	assert.Error(t, err)
+}
+
+func TestFungibleAssetClient_Metadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/0xa/resource/0x1::fungible_asset::Metadata", r.URL.Path)
+		_, _ = w.Write([]byte(`{"type":"0x1::fungible_asset::Metadata","data":{"decimals":6,"icon_uri":"https://example.com/icon.png","name":"Test Coin","project_uri":"","symbol":"TEST"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(NetworkConfig{NodeUrl: server.URL, ChainId: 4})
+	assert.NoError(t, err)
+	faClient := &FungibleAssetClient{aptosClient: client, metadataAddress: &AccountAddress{31: 0xA}}
+
+	metadata, err := faClient.Metadata()
+	assert.NoError(t, err)
+	assert.Equal(t, &FungibleAssetMetadata{
+		Name:       "Test Coin",
+		Symbol:     "TEST",
+		Decimals:   6,
+		IconURI:    "https://example.com/icon.png",
+		ProjectURI: "",
+	}, metadata)
+}
+
+func TestFungibleAssetClient_TransferPrimaryStorePayload(t *testing.T) {
+	faClient := &FungibleAssetClient{metadataAddress: &AccountAddress{31: 0xA}}
+	payload, err := faClient.TransferPrimaryStorePayload(AccountTwo, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "primary_fungible_store", payload.Module.Name)
+	assert.Equal(t, "transfer", payload.Function)
+	assert.Equal(t, []TypeTag{metadataStructTag()}, payload.ArgTypes)
+	assert.Equal(t, [][]byte{faClient.metadataAddress[:], AccountTwo[:], {100, 0, 0, 0, 0, 0, 0, 0}}, payload.Args)
+}
@@ -0,0 +1,94 @@
+package aptos
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultHealthProcessor is the indexer processor used by [Client.HealthReport] to measure indexer lag
+const DefaultHealthProcessor = "default_processor"
+
+// HealthProcessor is an option to [Client.HealthReport], the indexer processor to measure lag with
+type HealthProcessor string
+
+// HealthReport is the health of the node, and of the indexer if one is configured, from [Client.HealthReport]
+//
+// Each component is checked independently, so a failure in one still reports the others.
+type HealthReport struct {
+	NodeHealthy   bool   // NodeHealthy is true if the node responded to its health check
+	NodeError     error  // NodeError is the first error checking the node, if any
+	LedgerVersion uint64 // LedgerVersion is the latest ledger version of the node
+	BlockHeight   uint64 // BlockHeight is the latest block height of the node
+
+	IndexerConfigured bool   // IndexerConfigured is true if the client has an indexer, otherwise the indexer is not checked
+	IndexerError      error  // IndexerError is the error checking the indexer, if any
+	IndexerVersion    uint64 // IndexerVersion is the last ledger version processed by the indexer processor
+	IndexerLag        uint64 // IndexerLag is how many versions the indexer is behind the node, only set if both are known
+}
+
+// Healthy returns true if the node is healthy, and the indexer is healthy if it is configured
+func (report *HealthReport) Healthy() bool {
+	return report.NodeHealthy && report.NodeError == nil && (!report.IndexerConfigured || report.IndexerError == nil)
+}
+
+// Err joins the errors from all components, or returns nil if there are none
+func (report *HealthReport) Err() error {
+	var errs []error
+	if report.NodeError != nil {
+		errs = append(errs, fmt.Errorf("node: %w", report.NodeError))
+	}
+	if report.IndexerError != nil {
+		errs = append(errs, fmt.Errorf("indexer: %w", report.IndexerError))
+	}
+	return errors.Join(errs...)
+}
+
+// HealthReport checks the health of the node, and the indexer if one is configured, in one call.  This is useful as a
+// readiness check before starting work.
+//
+// Errors checking a component are recorded in the report rather than returned, so partial results are still available.
+// An error is only returned for invalid options.
+//
+// Accepts option [HealthProcessor], the indexer processor to measure lag with.  Default [DefaultHealthProcessor].
+//
+//	report, err := client.HealthReport()
+//	if err != nil || !report.Healthy() {
+//		// not ready yet
+//	}
+//	fmt.Printf("Indexer is %d versions behind\n", report.IndexerLag)
+func (client *Client) HealthReport(options ...any) (*HealthReport, error) {
+	processor := DefaultHealthProcessor
+	for i, arg := range options {
+		switch value := arg.(type) {
+		case HealthProcessor:
+			processor = string(value)
+		default:
+			return nil, fmt.Errorf("HealthReport arg [%d] unknown option type %T", i+1, arg)
+		}
+	}
+
+	report := &HealthReport{}
+
+	// Check the indexer first, so the lag isn't understated by the node moving ahead in between
+	if client.indexerClient != nil {
+		report.IndexerConfigured = true
+		report.IndexerVersion, report.IndexerError = client.indexerClient.GetProcessorStatus(processor)
+	}
+
+	_, report.NodeError = client.nodeClient.NodeHealthCheck()
+	report.NodeHealthy = report.NodeError == nil
+	info, err := client.nodeClient.Info()
+	if err != nil {
+		if report.NodeError == nil {
+			report.NodeError = err
+		}
+	} else {
+		report.LedgerVersion = info.LedgerVersion()
+		report.BlockHeight = info.BlockHeight()
+	}
+
+	if report.IndexerConfigured && report.IndexerError == nil && err == nil && report.LedgerVersion > report.IndexerVersion {
+		report.IndexerLag = report.LedgerVersion - report.IndexerVersion
+	}
+	return report, nil
+}
@@ -0,0 +1,63 @@
+package aptos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthReport(t *testing.T) {
+	nodeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/-/healthy":
+			_, _ = w.Write([]byte(`{"message":"aptos-node:ok"}`))
+		case "/":
+			_, _ = w.Write([]byte(`{"chain_id":4,"epoch":"1","ledger_version":"100","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer nodeServer.Close()
+	indexerServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"processor_status":[{"last_success_version":90}]}}`))
+	}))
+	defer indexerServer.Close()
+
+	// Node and indexer are both checked
+	client, err := NewClient(NetworkConfig{NodeUrl: nodeServer.URL, IndexerUrl: indexerServer.URL, ChainId: 4})
+	assert.NoError(t, err)
+	report, err := client.HealthReport()
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy())
+	assert.NoError(t, report.Err())
+	assert.Equal(t, uint64(100), report.LedgerVersion)
+	assert.Equal(t, uint64(5), report.BlockHeight)
+	assert.True(t, report.IndexerConfigured)
+	assert.Equal(t, uint64(90), report.IndexerVersion)
+	assert.Equal(t, uint64(10), report.IndexerLag)
+
+	// Without an indexer, only the node is checked
+	client, err = NewClient(NetworkConfig{NodeUrl: nodeServer.URL, ChainId: 4})
+	assert.NoError(t, err)
+	report, err = client.HealthReport()
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy())
+	assert.False(t, report.IndexerConfigured)
+
+	// A failing indexer still reports the node
+	indexerServer.Close()
+	client, err = NewClient(NetworkConfig{NodeUrl: nodeServer.URL, IndexerUrl: indexerServer.URL, ChainId: 4})
+	assert.NoError(t, err)
+	report, err = client.HealthReport(HealthProcessor("fungible_asset_processor"))
+	assert.NoError(t, err)
+	assert.False(t, report.Healthy())
+	assert.True(t, report.NodeHealthy)
+	assert.Equal(t, uint64(100), report.LedgerVersion)
+	assert.Error(t, report.IndexerError)
+	assert.ErrorContains(t, report.Err(), "indexer")
+
+	_, err = client.HealthReport(5)
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+package aptos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// NewHedgedClient wraps an [http.Client] so that slow GET requests are hedged.  If a GET request hasn't responded
+// within delay, a duplicate request is sent, up to maxAttempts requests in total.  The first response wins and the
+// other requests are cancelled.  A 5xx or 429 response counts as a failure like a connection error, so another attempt
+// is sent straight away; if every attempt fails, the last of those responses is returned.
+//
+// Only GET requests without a body are hedged, so transactions are never submitted twice.  This is most useful when
+// the node URL is load balanced across multiple fullnodes, as the hedge may be served by a faster node.  delay must be
+// positive, and maxAttempts must be at least 1.
+//
+//	httpClient, err := NewHedgedClient(http.DefaultClient, 200*time.Millisecond, 2)
+//	client, err := NewClient(MainnetConfig, httpClient)
+func NewHedgedClient(inner *http.Client, delay time.Duration, maxAttempts int) (*http.Client, error) {
+	if delay <= 0 {
+		return nil, fmt.Errorf("hedge delay must be positive, got %s", delay)
+	}
+	if maxAttempts < 1 {
+		return nil, fmt.Errorf("max attempts must be at least 1, got %d", maxAttempts)
+	}
+	transport := inner.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client := *inner
+	client.Transport = &hedgedTransport{
+		inner:       transport,
+		delay:       delay,
+		maxAttempts: maxAttempts,
+	}
+	return &client, nil
+}
+
+// hedgedTransport is an [http.RoundTripper] that sends duplicate GET requests after a delay
+type hedgedTransport struct {
+	inner       http.RoundTripper
+	delay       time.Duration
+	maxAttempts int
+}
+
+// hedgedResult is the result of a single attempt of a hedged request
+type hedgedResult struct {
+	attempt  int
+	response *http.Response
+	err      error
+}
+
+// innerTransport returns the wrapped transport
+func (ht *hedgedTransport) innerTransport() http.RoundTripper {
+	return ht.inner
+}
+
+// setInnerTransport replaces the wrapped transport
+func (ht *hedgedTransport) setInnerTransport(inner http.RoundTripper) {
+	ht.inner = inner
+}
+
+// RoundTrip sends the request, hedging it if it is a GET request
+//
+// Implements:
+//   - [http.RoundTripper]
+func (ht *hedgedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || (req.Body != nil && req.Body != http.NoBody) || ht.maxAttempts <= 1 {
+		return ht.inner.RoundTrip(req)
+	}
+
+	results := make(chan hedgedResult, ht.maxAttempts)
+	cancels := make([]context.CancelFunc, 0, ht.maxAttempts)
+	send := func() {
+		ctx, cancel := context.WithCancel(req.Context())
+		cancels = append(cancels, cancel)
+		attempt := len(cancels) - 1
+		go func() {
+			response, err := ht.inner.RoundTrip(req.Clone(ctx))
+			results <- hedgedResult{attempt: attempt, response: response, err: err}
+		}()
+	}
+
+	send()
+	timer := time.NewTimer(ht.delay)
+	defer timer.Stop()
+
+	var lastErr error
+	// lastFailed is the most recent retryable error response, returned if every attempt fails
+	var lastFailed *hedgedResult
+	pending := 1
+	for {
+		select {
+		case <-timer.C:
+			if len(cancels) < ht.maxAttempts {
+				send()
+				pending++
+				timer.Reset(ht.delay)
+			}
+		case result := <-results:
+			pending--
+			if result.err == nil && !isHedgeRetryableStatus(result.response.StatusCode) {
+				// Cancel the losers, and close their responses as they come in
+				for i, cancel := range cancels {
+					if i != result.attempt {
+						cancel()
+					}
+				}
+				if lastFailed != nil {
+					_ = lastFailed.response.Body.Close()
+				}
+				go drainHedgedResults(results, pending)
+				return hedgedWinner(result, cancels), nil
+			}
+
+			if result.err != nil {
+				lastErr = result.err
+				cancels[result.attempt]()
+			} else {
+				// Keep the response in case nothing better comes, its context is cancelled once it's closed
+				if lastFailed != nil {
+					_ = lastFailed.response.Body.Close()
+					cancels[lastFailed.attempt]()
+				}
+				lastFailed = &result
+			}
+			if pending == 0 {
+				if len(cancels) >= ht.maxAttempts {
+					if lastFailed != nil {
+						return hedgedWinner(*lastFailed, cancels), nil
+					}
+					return nil, lastErr
+				}
+				// Don't wait for the delay if every request has already failed
+				send()
+				pending++
+				timer.Reset(ht.delay)
+			}
+		}
+	}
+}
+
+// hedgedWinner returns the response of the given attempt, whose context is only cancelled once the body has been read
+func hedgedWinner(result hedgedResult, cancels []context.CancelFunc) *http.Response {
+	result.response.Body = &cancelOnCloseBody{ReadCloser: result.response.Body, cancel: cancels[result.attempt]}
+	return result.response
+}
+
+// isHedgeRetryableStatus tells if a response status means another attempt may succeed, i.e. server errors and rate
+// limiting
+func isHedgeRetryableStatus(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests
+}
+
+// drainHedgedResults closes the responses of cancelled requests, so their connections can be reused
+func drainHedgedResults(results chan hedgedResult, pending int) {
+	for range pending {
+		result := <-results
+		if result.err == nil {
+			_ = result.response.Body.Close()
+		}
+	}
+}
+
+// cancelOnCloseBody cancels the request's context when the response body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the request's context
+func (body *cancelOnCloseBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.cancel()
+	return err
+}
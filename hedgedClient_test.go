@@ -0,0 +1,99 @@
+package aptos
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHedgedClient(t *testing.T) {
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request is slow, and should lose to the hedge
+		if requests.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			_, _ = w.Write([]byte("slow"))
+			return
+		}
+		_, _ = w.Write([]byte("fast"))
+	}))
+	defer server.Close()
+
+	client, err := NewHedgedClient(&http.Client{}, 10*time.Millisecond, 2)
+	assert.NoError(t, err)
+	start := time.Now()
+	response, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.NoError(t, response.Body.Close())
+	assert.Equal(t, "fast", string(body))
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// POSTs are never hedged
+	requests.Store(1)
+	response, err = client.Post(server.URL, "text/plain", strings.NewReader("body"))
+	assert.NoError(t, err)
+	assert.NoError(t, response.Body.Close())
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestHedgedClientRetryableStatus(t *testing.T) {
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request fails fast, and shouldn't win over the hedge
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	// The delay is long, so the hedge is only sent early because the first attempt failed
+	client, err := NewHedgedClient(&http.Client{}, 5*time.Second, 2)
+	assert.NoError(t, err)
+	start := time.Now()
+	response, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "ok", string(body))
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// If every attempt fails, the last response is returned
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte("slow down"))
+	}))
+	defer failing.Close()
+	response, err = client.Get(failing.URL)
+	assert.NoError(t, err)
+	body, err = io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.Equal(t, "slow down", string(body))
+}
+
+func TestHedgedClientInvalid(t *testing.T) {
+	_, err := NewHedgedClient(&http.Client{}, 0, 2)
+	assert.Error(t, err)
+	_, err = NewHedgedClient(&http.Client{}, -time.Second, 2)
+	assert.Error(t, err)
+	_, err = NewHedgedClient(&http.Client{}, time.Second, 0)
+	assert.Error(t, err)
+}
@@ -64,6 +64,130 @@ func (ic *IndexerClient) GetCoinBalances(address AccountAddress) ([]CoinBalance,
 	return out, nil
 }
 
+// FungibleAssetBalance is the balance of a single fungible asset store, see [IndexerClient.GetFungibleAssetBalances]
+type FungibleAssetBalance struct {
+	AssetType    string         // AssetType is the metadata address for fungible assets, or the coin type for coins e.g. 0x1::aptos_coin::AptosCoin
+	StoreAddress AccountAddress // StoreAddress is the address of the fungible store
+	IsPrimary    bool           // IsPrimary is true if the store is the primary store of the owner
+	Amount       uint64         // Amount is the balance of the store
+}
+
+// GetFungibleAssetBalances retrieves the balances of all fungible assets owned by the address, including coins
+func (ic *IndexerClient) GetFungibleAssetBalances(address AccountAddress) ([]FungibleAssetBalance, error) {
+	var q struct {
+		CurrentFungibleAssetBalances []struct {
+			AssetType string `graphql:"asset_type"`
+			StorageId string `graphql:"storage_id"`
+			IsPrimary bool   `graphql:"is_primary"`
+			Amount    uint64
+		} `graphql:"current_fungible_asset_balances(where: {owner_address: {_eq: $address}})"`
+	}
+
+	variables := map[string]any{
+		"address": address.StringLong(),
+	}
+	err := ic.Query(&q, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]FungibleAssetBalance, len(q.CurrentFungibleAssetBalances))
+	for i, balance := range q.CurrentFungibleAssetBalances {
+		out[i] = FungibleAssetBalance{
+			AssetType: balance.AssetType,
+			IsPrimary: balance.IsPrimary,
+			Amount:    balance.Amount,
+		}
+		err = out[i].StoreAddress.ParseStringRelaxed(balance.StorageId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// OwnedToken is a digital asset owned by an account, see [IndexerClient.GetOwnedTokens]
+type OwnedToken struct {
+	TokenAddress      AccountAddress // TokenAddress is the address of the token object
+	TokenName         string         // TokenName is the name of the token
+	CollectionAddress AccountAddress // CollectionAddress is the address of the collection object
+	Amount            uint64         // Amount is the number of the token owned, 1 for non-fungible tokens
+}
+
+// GetOwnedTokens retrieves all digital assets currently owned by the address
+func (ic *IndexerClient) GetOwnedTokens(address AccountAddress) ([]OwnedToken, error) {
+	var q struct {
+		CurrentTokenOwnershipsV2 []struct {
+			TokenDataId      string `graphql:"token_data_id"`
+			Amount           uint64
+			CurrentTokenData struct {
+				TokenName    string `graphql:"token_name"`
+				CollectionId string `graphql:"collection_id"`
+			} `graphql:"current_token_data"`
+		} `graphql:"current_token_ownerships_v2(where: {owner_address: {_eq: $address}, amount: {_gt: 0}})"`
+	}
+
+	variables := map[string]any{
+		"address": address.StringLong(),
+	}
+	err := ic.Query(&q, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]OwnedToken, len(q.CurrentTokenOwnershipsV2))
+	for i, token := range q.CurrentTokenOwnershipsV2 {
+		out[i] = OwnedToken{
+			TokenName: token.CurrentTokenData.TokenName,
+			Amount:    token.Amount,
+		}
+		err = out[i].TokenAddress.ParseStringRelaxed(token.TokenDataId)
+		if err != nil {
+			return nil, err
+		}
+		err = out[i].CollectionAddress.ParseStringRelaxed(token.CurrentTokenData.CollectionId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// indexerBigInt is a query variable for the indexer's bigint columns, which don't accept an Int e.g. transaction versions
+type indexerBigInt uint64
+
+// GetGraphQLType gives the GraphQL type of the variable
+func (indexerBigInt) GetGraphQLType() string {
+	return "bigint"
+}
+
+// GetAccountTransactionVersions retrieves the versions of transactions involving the address, from startVersion onward
+// in ascending order, up to limit versions.  Unlike the node's account transactions, this includes transactions sent by
+// other accounts e.g. incoming transfers.
+func (ic *IndexerClient) GetAccountTransactionVersions(address AccountAddress, startVersion uint64, limit uint64) ([]uint64, error) {
+	var q struct {
+		AccountTransactions []struct {
+			TransactionVersion uint64 `graphql:"transaction_version"`
+		} `graphql:"account_transactions(where: {account_address: {_eq: $address}, transaction_version: {_gte: $start_version}}, order_by: {transaction_version: asc}, limit: $limit)"`
+	}
+
+	variables := map[string]any{
+		"address":       address.StringLong(),
+		"start_version": indexerBigInt(startVersion),
+		"limit":         limit,
+	}
+	err := ic.Query(&q, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]uint64, len(q.AccountTransactions))
+	for i, txn := range q.AccountTransactions {
+		out[i] = txn.TransactionVersion
+	}
+	return out, nil
+}
+
 // GetProcessorStatus tells the most updated version of the transaction processor.  This helps to determine freshness of data.
 func (ic *IndexerClient) GetProcessorStatus(processorName string) (uint64, error) {
 	var q struct {
@@ -78,8 +202,11 @@ func (ic *IndexerClient) GetProcessorStatus(processorName string) (uint64, error
 	if err != nil {
 		return 0, err
 	}
+	if len(q.ProcessorStatus) == 0 {
+		return 0, fmt.Errorf("no status for processor %s", processorName)
+	}
 
-	return q.ProcessorStatus[0].LastSuccessVersion, err
+	return q.ProcessorStatus[0].LastSuccessVersion, nil
 }
 
 // WaitOnIndexer waits for the indexer processorName specified to catch up to the requestedVersion
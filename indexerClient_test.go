@@ -0,0 +1,56 @@
+package aptos
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestIndexerClient creates an indexer client against a fake indexer, which always responds with data
+func newTestIndexerClient(t *testing.T, data string) *IndexerClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := struct {
+			Variables map[string]any `json:"variables"`
+		}{}
+		err := json.NewDecoder(r.Body).Decode(&request)
+		assert.NoError(t, err)
+		assert.Equal(t, AccountTwo.StringLong(), request.Variables["address"])
+		_, _ = w.Write([]byte(`{"data":` + data + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return NewIndexerClient(server.Client(), server.URL)
+}
+
+func TestIndexerClient_GetFungibleAssetBalances(t *testing.T) {
+	client := newTestIndexerClient(t, `{"current_fungible_asset_balances":[{"asset_type":"0x1::aptos_coin::AptosCoin","storage_id":"0xa","is_primary":true,"amount":100}]}`)
+	balances, err := client.GetFungibleAssetBalances(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, []FungibleAssetBalance{{
+		AssetType:    "0x1::aptos_coin::AptosCoin",
+		StoreAddress: AccountAddress{31: 0xA},
+		IsPrimary:    true,
+		Amount:       100,
+	}}, balances)
+}
+
+func TestIndexerClient_GetOwnedTokens(t *testing.T) {
+	client := newTestIndexerClient(t, `{"current_token_ownerships_v2":[{"token_data_id":"0xb","amount":1,"current_token_data":{"token_name":"Token #1","collection_id":"0xc"}}]}`)
+	tokens, err := client.GetOwnedTokens(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, []OwnedToken{{
+		TokenAddress:      AccountAddress{31: 0xB},
+		TokenName:         "Token #1",
+		CollectionAddress: AccountAddress{31: 0xC},
+		Amount:            1,
+	}}, tokens)
+}
+
+func TestIndexerClient_GetAccountTransactionVersions(t *testing.T) {
+	client := newTestIndexerClient(t, `{"account_transactions":[{"transaction_version":10},{"transaction_version":12}]}`)
+	versions, err := client.GetAccountTransactionVersions(AccountTwo, 10, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{10, 12}, versions)
+}
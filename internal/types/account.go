@@ -90,6 +90,14 @@ func (account *Account) AccountAddress() AccountAddress {
 	return account.Address
 }
 
+// Zeroize zeroizes the account's private key if it supports it, e.g. [crypto.Ed25519PrivateKey.Zeroize].  Signing
+// afterward returns [crypto.ErrKeyZeroized].
+func (account *Account) Zeroize() {
+	if zeroizer, ok := account.Signer.(interface{ Zeroize() }); ok {
+		zeroizer.Zeroize()
+	}
+}
+
 // ErrAddressTooShort is returned when an AccountAddress is too short
 var ErrAddressTooShort = errors.New("AccountAddress too short")
 
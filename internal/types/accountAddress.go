@@ -65,6 +65,15 @@ func (aa *AccountAddress) FromAuthKey(authKey *crypto.AuthenticationKey) {
 	copy(aa[:], authKey[:])
 }
 
+// AddressFromPublicKey derives the [AccountAddress] of an account created with the public key, which is the same as its
+// [crypto.AuthenticationKey].  This doesn't account for key rotation, where the address stays the same but the key
+// changes.
+func AddressFromPublicKey(publicKey crypto.PublicKey) AccountAddress {
+	address := AccountAddress{}
+	address.FromAuthKey(publicKey.AuthKey())
+	return address
+}
+
 // AuthKey converts [AccountAddress] to [crypto.AuthenticationKey]
 func (aa *AccountAddress) AuthKey() *crypto.AuthenticationKey {
 	authKey := &crypto.AuthenticationKey{}
@@ -123,6 +132,21 @@ func (aa *AccountAddress) ResourceAccount(seed []byte) (accountAddress AccountAd
 	return aa.DerivedAddress(seed, crypto.ResourceAccountScheme)
 }
 
+// ObjectAddressFromGuid derives an object address from the creator's GUID creation number, as used by
+// `0x1::object::create_object_from_account`.  The creation number is the creator's GUID counter at creation time.
+//
+// This is the same as `0x1::object::create_guid_object_address` on-chain.
+func (aa *AccountAddress) ObjectAddressFromGuid(creationNum uint64) (accountAddress AccountAddress) {
+	// The GUID is serialized as BCS, the creation number first then the address
+	ser := bcs.Serializer{}
+	ser.U64(creationNum)
+	ser.Struct(aa)
+	authKey := crypto.AuthenticationKey{}
+	authKey.FromBytesAndScheme(ser.ToBytes(), crypto.ObjectFromGuidScheme)
+	copy(accountAddress[:], authKey[:])
+	return
+}
+
 // DerivedAddress addresses are derived by the address, the seed, then the type byte
 func (aa *AccountAddress) DerivedAddress(seed []byte, typeByte uint8) (accountAddress AccountAddress) {
 	authKey := aa.AuthKey()
@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/json"
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
+	"github.com/aptos-labs/aptos-go-sdk/internal/util"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -130,6 +132,30 @@ func TestAccountAddress_ObjectAddressFromObject(t *testing.T) {
 	assert.Equal(t, expectedDerivedAddress, derivedAddress)
 }
 
+func TestAccountAddress_ResourceAccount(t *testing.T) {
+	var owner AccountAddress
+	err := owner.ParseStringRelaxed(defaultOwner)
+	assert.NoError(t, err)
+
+	seed := []byte("seed")
+	expected := util.Sha3256Hash([][]byte{owner[:], seed, {crypto.ResourceAccountScheme}})
+	derivedAddress := owner.ResourceAccount(seed)
+	assert.Equal(t, expected, derivedAddress[:])
+}
+
+func TestAccountAddress_ObjectAddressFromGuid(t *testing.T) {
+	var owner AccountAddress
+	err := owner.ParseStringRelaxed(defaultOwner)
+	assert.NoError(t, err)
+
+	// GUID is BCS serialized as the creation number in little endian, followed by the address
+	creationNum := []byte{5, 0, 0, 0, 0, 0, 0, 0}
+	expected := util.Sha3256Hash([][]byte{creationNum, owner[:], {crypto.ObjectFromGuidScheme}})
+	derivedAddress := owner.ObjectAddressFromGuid(5)
+	assert.Equal(t, expected, derivedAddress[:])
+	assert.NotEqual(t, derivedAddress, owner.ObjectAddressFromGuid(6))
+}
+
 func TestAccountAddress_JSON(t *testing.T) {
 	type testStruct struct {
 		Address *AccountAddress `json:"address"`
@@ -145,3 +171,19 @@ func TestAccountAddress_JSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, str, string(b))
 }
+
+func TestAddressFromPublicKey(t *testing.T) {
+	ed25519Key, err := crypto.GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	secp256k1Key, err := crypto.GenerateSecp256k1Key()
+	assert.NoError(t, err)
+
+	for _, signer := range []crypto.Signer{ed25519Key, crypto.NewSingleSigner(ed25519Key), crypto.NewSingleSigner(secp256k1Key)} {
+		account, err := NewAccountFromSigner(signer)
+		assert.NoError(t, err)
+		assert.Equal(t, account.Address, AddressFromPublicKey(signer.PubKey()))
+	}
+
+	// Different schemes for the same key have different addresses
+	assert.NotEqual(t, AddressFromPublicKey(ed25519Key.PubKey()), AddressFromPublicKey(crypto.NewSingleSigner(ed25519Key).PubKey()))
+}
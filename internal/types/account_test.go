@@ -64,3 +64,23 @@ func TestNewAccountFromSignerWithAddressMulti(t *testing.T) {
 	_, err = NewAccountFromSigner(key, authenticationKey, authenticationKey)
 	assert.Error(t, err)
 }
+
+func TestAccountZeroize(t *testing.T) {
+	for _, newAccount := range []func() (*Account, error){NewEd25519Account, NewEd25519SingleSignerAccount, NewSecp256k1Account} {
+		account, err := newAccount()
+		assert.NoError(t, err)
+		pubKey := account.PubKey()
+		authKey := account.AuthKey()
+
+		account.Zeroize()
+		_, err = account.Sign([]byte{0x12, 0x34})
+		assert.ErrorIs(t, err, crypto.ErrKeyZeroized)
+		_, err = account.SignMessage([]byte{0x12, 0x34})
+		assert.ErrorIs(t, err, crypto.ErrKeyZeroized)
+
+		// The public parts are still usable
+		assert.Equal(t, pubKey, account.PubKey())
+		assert.Equal(t, authKey, account.AuthKey())
+		assert.NotNil(t, account.SimulationAuthenticator())
+	}
+}
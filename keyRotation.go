@@ -0,0 +1,109 @@
+package aptos
+
+import (
+	"fmt"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
+)
+
+// rotationKeyScheme checks that the key can be used with `0x1::account::rotate_authentication_key`, which only
+// supports [crypto.Ed25519Scheme] and [crypto.MultiEd25519Scheme] keys
+func rotationKeyScheme(publicKey crypto.PublicKey) (uint8, error) {
+	if publicKey == nil {
+		return 0, fmt.Errorf("public key must not be nil")
+	}
+	switch scheme := publicKey.Scheme(); scheme {
+	case crypto.Ed25519Scheme, crypto.MultiEd25519Scheme:
+		return scheme, nil
+	default:
+		return 0, fmt.Errorf("key rotation only supports Ed25519 and MultiEd25519 keys, got scheme %d", scheme)
+	}
+}
+
+// BuildRotationProof builds the message for a `0x1::account::RotationProofChallenge`, which must be signed by both the
+// current key and the new key to rotate an account's authentication key.
+//
+// Args:
+//   - sequenceNumber is the current sequence number of the account being rotated
+//   - originator is the address of the account being rotated
+//   - currentAuthKey is the account's current authentication key
+//   - newPublicKey is the public key to rotate to
+//
+// The signatures are then passed to [RotateAuthenticationKeyPayload]:
+//
+//	challenge, _ := BuildRotationProof(info.SequenceNumber(), account.Address, currentAuthKey, newKey.PubKey())
+//	capRotateKey, _ := currentKey.SignMessage(challenge)
+//	capUpdateTable, _ := newKey.SignMessage(challenge)
+func BuildRotationProof(sequenceNumber uint64, originator AccountAddress, currentAuthKey crypto.AuthenticationKey, newPublicKey crypto.PublicKey) ([]byte, error) {
+	if _, err := rotationKeyScheme(newPublicKey); err != nil {
+		return nil, err
+	}
+	return bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		// Signed messages are prefixed by the type info of the struct
+		ser.Struct(&AccountOne)
+		ser.WriteString("account")
+		ser.WriteString("RotationProofChallenge")
+
+		ser.U64(sequenceNumber)
+		ser.Struct(&originator)
+		ser.FixedBytes(currentAuthKey[:])
+		ser.WriteBytes(newPublicKey.Bytes())
+	})
+}
+
+// RotateAuthenticationKeyPayload builds an EntryFunction payload for rotating an account's authentication key, with
+// `0x1::account::rotate_authentication_key`.  The transaction must be signed by the account's current key.
+//
+// Args:
+//   - fromPublicKey is the account's current public key
+//   - toPublicKey is the public key to rotate to
+//   - capRotateKey is the signature of the [BuildRotationProof] message by the current key
+//   - capUpdateTable is the signature of the [BuildRotationProof] message by the new key
+func RotateAuthenticationKeyPayload(fromPublicKey crypto.PublicKey, toPublicKey crypto.PublicKey, capRotateKey crypto.Signature, capUpdateTable crypto.Signature) (payload *EntryFunction, err error) {
+	fromScheme, err := rotationKeyScheme(fromPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	toScheme, err := rotationKeyScheme(toPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if capRotateKey == nil || capUpdateTable == nil {
+		return nil, fmt.Errorf("signatures must not be nil")
+	}
+
+	fromPublicKeyBytes, err := bcs.SerializeBytes(fromPublicKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	toPublicKeyBytes, err := bcs.SerializeBytes(toPublicKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	capRotateKeyBytes, err := bcs.SerializeBytes(capRotateKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	capUpdateTableBytes, err := bcs.SerializeBytes(capUpdateTable.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntryFunction{
+		Module: ModuleId{
+			Address: AccountOne,
+			Name:    "account",
+		},
+		Function: "rotate_authentication_key",
+		ArgTypes: []TypeTag{},
+		Args: [][]byte{
+			{fromScheme},
+			fromPublicKeyBytes,
+			{toScheme},
+			toPublicKeyBytes,
+			capRotateKeyBytes,
+			capUpdateTableBytes,
+		},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package aptos
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyRotation(t *testing.T) {
+	currentKey, err := crypto.GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	newKey, err := crypto.GenerateEd25519PrivateKey()
+	assert.NoError(t, err)
+	currentAuthKey := currentKey.AuthKey()
+	originator := AccountAddress(*currentAuthKey)
+
+	challenge, err := BuildRotationProof(5, originator, *currentAuthKey, newKey.PubKey())
+	assert.NoError(t, err)
+
+	expected := bytes.Buffer{}
+	expected.Write(AccountOne[:])
+	expected.WriteByte(7)
+	expected.WriteString("account")
+	expected.WriteByte(22)
+	expected.WriteString("RotationProofChallenge")
+	expected.Write([]byte{5, 0, 0, 0, 0, 0, 0, 0})
+	expected.Write(originator[:])
+	expected.Write(currentAuthKey[:])
+	expected.WriteByte(32)
+	expected.Write(newKey.PubKey().Bytes())
+	assert.Equal(t, expected.Bytes(), challenge)
+
+	capRotateKey, err := currentKey.SignMessage(challenge)
+	assert.NoError(t, err)
+	capUpdateTable, err := newKey.SignMessage(challenge)
+	assert.NoError(t, err)
+	assert.True(t, currentKey.PubKey().Verify(challenge, capRotateKey))
+	assert.True(t, newKey.PubKey().Verify(challenge, capUpdateTable))
+
+	payload, err := RotateAuthenticationKeyPayload(currentKey.PubKey(), newKey.PubKey(), capRotateKey, capUpdateTable)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotate_authentication_key", payload.Function)
+	assert.Equal(t, "account", payload.Module.Name)
+	assert.Equal(t, []byte{crypto.Ed25519Scheme}, payload.Args[0])
+	assert.Equal(t, append([]byte{32}, currentKey.PubKey().Bytes()...), payload.Args[1])
+	assert.Equal(t, []byte{crypto.Ed25519Scheme}, payload.Args[2])
+	assert.Equal(t, append([]byte{32}, newKey.PubKey().Bytes()...), payload.Args[3])
+	assert.Equal(t, append([]byte{64}, capRotateKey.Bytes()...), payload.Args[4])
+	assert.Equal(t, append([]byte{64}, capUpdateTable.Bytes()...), payload.Args[5])
+
+	// Only Ed25519 and MultiEd25519 keys can be rotated to
+	secpKey, err := crypto.GenerateSecp256k1Key()
+	assert.NoError(t, err)
+	singleKey := &crypto.SingleSigner{Signer: secpKey}
+	_, err = BuildRotationProof(5, originator, *currentAuthKey, singleKey.PubKey())
+	assert.Error(t, err)
+	_, err = RotateAuthenticationKeyPayload(currentKey.PubKey(), singleKey.PubKey(), capRotateKey, capUpdateTable)
+	assert.Error(t, err)
+	_, err = RotateAuthenticationKeyPayload(currentKey.PubKey(), newKey.PubKey(), nil, capUpdateTable)
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package aptos
+
+import (
+	"sync"
+	"time"
+)
+
+// ledgerCache caches ledger information that is safe to reuse for a short period of time.  It is safe for concurrent
+// use.
+type ledgerCache struct {
+	lock              sync.Mutex
+	ttl               time.Duration   // How long a gas estimate is valid for
+	gasEstimateInfo   EstimateGasInfo // The cached gas estimate
+	gasEstimateExpiry time.Time       // When the cached gas estimate expires, zero if there isn't one
+}
+
+// gasEstimate returns the cached gas estimate, and whether it is still valid
+func (cache *ledgerCache) gasEstimate() (EstimateGasInfo, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if cache.gasEstimateExpiry.IsZero() || !time.Now().Before(cache.gasEstimateExpiry) {
+		return EstimateGasInfo{}, false
+	}
+	return cache.gasEstimateInfo, true
+}
+
+// setGasEstimate caches the gas estimate for the TTL
+func (cache *ledgerCache) setGasEstimate(info EstimateGasInfo) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.gasEstimateInfo = info
+	cache.gasEstimateExpiry = time.Now().Add(cache.ttl)
+}
+
+// invalidate clears the cached gas estimate
+func (cache *ledgerCache) invalidate() {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.gasEstimateInfo = EstimateGasInfo{}
+	cache.gasEstimateExpiry = time.Time{}
+}
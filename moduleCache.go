@@ -0,0 +1,65 @@
+package aptos
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aptos-labs/aptos-go-sdk/api"
+)
+
+// moduleCacheKey identifies a module by its address and name
+type moduleCacheKey struct {
+	address AccountAddress
+	name    string
+}
+
+// moduleCacheEntry is a cached module, and when it expires
+type moduleCacheEntry struct {
+	module *api.MoveBytecode
+	expiry time.Time
+}
+
+// moduleCache caches module bytecode and ABIs, which rarely change.  It is safe for concurrent use.
+type moduleCache struct {
+	lock    sync.Mutex
+	ttl     time.Duration // How long a module is valid for
+	modules map[moduleCacheKey]moduleCacheEntry
+}
+
+// newModuleCache creates an empty cache with the given TTL
+func newModuleCache(ttl time.Duration) *moduleCache {
+	return &moduleCache{
+		ttl:     ttl,
+		modules: make(map[moduleCacheKey]moduleCacheEntry),
+	}
+}
+
+// module returns the cached module, and whether it is still valid
+func (cache *moduleCache) module(address AccountAddress, name string) (*api.MoveBytecode, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	key := moduleCacheKey{address, name}
+	entry, ok := cache.modules[key]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expiry) {
+		delete(cache.modules, key)
+		return nil, false
+	}
+	return entry.module, true
+}
+
+// setModule caches the module for the TTL
+func (cache *moduleCache) setModule(address AccountAddress, name string, module *api.MoveBytecode) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.modules[moduleCacheKey{address, name}] = moduleCacheEntry{module: module, expiry: time.Now().Add(cache.ttl)}
+}
+
+// invalidate clears the cached module
+func (cache *moduleCache) invalidate(address AccountAddress, name string) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	delete(cache.modules, moduleCacheKey{address, name})
+}
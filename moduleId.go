@@ -10,6 +10,26 @@ type ModuleId struct {
 	Name    string
 }
 
+// AptosAccountModule returns the ModuleId for 0x1::aptos_account, used for transfers e.g. [CoinTransferPayload]
+func AptosAccountModule() ModuleId {
+	return ModuleId{Address: AccountOne, Name: "aptos_account"}
+}
+
+// CoinModule returns the ModuleId for 0x1::coin
+func CoinModule() ModuleId {
+	return ModuleId{Address: AccountOne, Name: "coin"}
+}
+
+// FungibleAssetModule returns the ModuleId for 0x1::fungible_asset
+func FungibleAssetModule() ModuleId {
+	return ModuleId{Address: AccountOne, Name: "fungible_asset"}
+}
+
+// String outputs the module in the form address::name e.g. 0x1::coin
+func (mod *ModuleId) String() string {
+	return mod.Address.String() + "::" + mod.Name
+}
+
 func (mod *ModuleId) MarshalBCS(ser *bcs.Serializer) {
 	mod.Address.MarshalBCS(ser)
 	ser.WriteString(mod.Name)
@@ -1,6 +1,12 @@
 package aptos
 
-import "github.com/aptos-labs/aptos-go-sdk/bcs"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+)
 
 // FetchNextMultisigAddress retrieves the next multisig address to be created from the given account
 func (client *Client) FetchNextMultisigAddress(address AccountAddress) (*AccountAddress, error) {
@@ -25,6 +31,117 @@ func (client *Client) FetchNextMultisigAddress(address AccountAddress) (*Account
 	return multisigAddress, nil
 }
 
+// PredictMultisigAddress computes the address of the multisig account that creator will create when its sequence
+// number is sequenceNumber, without querying the chain.
+//
+// This matches `0x1::multisig_account::get_next_multisig_account_address` on-chain, which derives a resource address
+// from the creator using the seed `"aptos_framework::multisig_account" || bcs(sequenceNumber)`.
+func PredictMultisigAddress(creator AccountAddress, sequenceNumber uint64) AccountAddress {
+	ser := &bcs.Serializer{}
+	ser.FixedBytes([]byte(multisigAccountDomainSeparator))
+	ser.U64(sequenceNumber)
+	return creator.ResourceAccount(ser.ToBytes())
+}
+
+// multisigAccountDomainSeparator is the domain separator used by `0x1::multisig_account` when deriving addresses
+const multisigAccountDomainSeparator = "aptos_framework::multisig_account"
+
+// MultisigSignaturesRequired retrieves the number of signatures required to execute a transaction on the multisig account
+func (client *Client) MultisigSignaturesRequired(multisigAddress AccountAddress, ledgerVersion ...uint64) (uint64, error) {
+	viewResponse, err := client.View(multisigViewPayload("num_signatures_required", multisigAddress), ledgerVersion...)
+	if err != nil {
+		return 0, err
+	}
+	if len(viewResponse) == 0 {
+		return 0, errors.New("num_signatures_required returned no values")
+	}
+	numSignaturesRequired, ok := viewResponse[0].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected num_signatures_required response %v", viewResponse[0])
+	}
+	return StrToUint64(numSignaturesRequired)
+}
+
+// MultisigOwners retrieves the owners of the multisig account
+func (client *Client) MultisigOwners(multisigAddress AccountAddress, ledgerVersion ...uint64) ([]AccountAddress, error) {
+	viewResponse, err := client.View(multisigViewPayload("owners", multisigAddress), ledgerVersion...)
+	if err != nil {
+		return nil, err
+	}
+	if len(viewResponse) == 0 {
+		return nil, errors.New("owners returned no values")
+	}
+	rawOwners, ok := viewResponse[0].([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected owners response %v", viewResponse[0])
+	}
+	owners := make([]AccountAddress, len(rawOwners))
+	for i, rawOwner := range rawOwners {
+		owner, ok := rawOwner.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected owner %v", rawOwner)
+		}
+		err = owners[i].ParseStringRelaxed(owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return owners, nil
+}
+
+// multisigViewPayload creates a payload for a multisig_account view function that takes only the multisig address
+func multisigViewPayload(functionName string, multisigAddress AccountAddress) *ViewPayload {
+	return &ViewPayload{
+		Module: ModuleId{
+			Address: AccountOne,
+			Name:    "multisig_account",
+		},
+		Function: functionName,
+		ArgTypes: []TypeTag{},
+		Args:     [][]byte{multisigAddress[:]},
+	}
+}
+
+// SimulateMultisigPayload simulates executing a payload from the multisig account, before it is created on-chain with
+// [MultisigCreateTransactionPayload].  This lets owners preview the gas and outcome without spending a transaction.
+// The sender should be an owner of the multisig account.
+//
+// Options are passed to [Client.BuildTransaction], except [EstimateGasUnitPrice], [EstimateMaxGasAmount],
+// [EstimatePrioritizedGasUnitPrice], [SimulateMaxGas], and [SimulateGasUnitPrice], which are passed to
+// [Client.SimulateTransaction].
+//
+//	simulation, err := client.SimulateMultisigPayload(owner, multisigAddress, multisigPayload)
+//	if err == nil && !simulation.Success {
+//		// don't create the multisig transaction, it would fail
+//	}
+func (client *Client) SimulateMultisigPayload(sender TransactionSigner, multisigAddress AccountAddress, payload *MultisigTransactionPayload, options ...any) (*api.UserTransaction, error) {
+	var buildOptions, simulateOptions []any
+	for _, option := range options {
+		switch option.(type) {
+		case EstimateGasUnitPrice, EstimateMaxGasAmount, EstimatePrioritizedGasUnitPrice, SimulateMaxGas, SimulateGasUnitPrice:
+			simulateOptions = append(simulateOptions, option)
+		default:
+			buildOptions = append(buildOptions, option)
+		}
+	}
+
+	rawTxn, err := client.BuildTransaction(sender.AccountAddress(), TransactionPayload{Payload: &Multisig{
+		MultisigAddress: multisigAddress,
+		Payload:         payload,
+	}}, buildOptions...)
+	if err != nil {
+		return nil, err
+	}
+	simulations, err := client.SimulateTransaction(rawTxn, sender, simulateOptions...)
+	if err != nil {
+		return nil, err
+	}
+	if len(simulations) == 0 {
+		return nil, errors.New("simulation returned no transactions")
+	}
+	return simulations[0], nil
+}
+
 // -- Multisig payloads --
 
 // MultisigCreateAccountPayload creates a payload for setting up a multisig
@@ -44,11 +161,7 @@ func MultisigCreateAccountPayload(requiredSigners uint64, additionalAddresses []
 	}
 
 	// TODO: This is a little better than before, but maybe we make some of these ahead of time
-	metadataKeysBytes, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
-		bcs.SerializeSequenceWithFunction(metadataKeys, ser, func(ser *bcs.Serializer, item string) {
-			ser.WriteString(item)
-		})
-	})
+	metadataKeysBytes, err := bcs.SerializeSequenceOf(metadataKeys, (*bcs.Serializer).WriteString)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,104 @@
+package aptos
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulateMultisigPayload(t *testing.T) {
+	owner, err := NewEd25519Account()
+	assert.NoError(t, err)
+	multisigAddress := AccountThree
+	transfer, err := CoinTransferPayload(nil, AccountTwo, 100)
+	assert.NoError(t, err)
+	payload := &MultisigTransactionPayload{Variant: MultisigTransactionPayloadVariantEntryFunction, Payload: transfer}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transactions/simulate", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("estimate_max_gas_amount"))
+
+		// The simulated transaction executes the payload from the multisig account
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		signedTxn := &SignedTransaction{}
+		assert.NoError(t, bcs.Deserialize(signedTxn, body))
+		rawTxn := signedTxn.Transaction
+		assert.Equal(t, owner.Address, rawTxn.Sender)
+		multisig, ok := rawTxn.Payload.Payload.(*Multisig)
+		assert.True(t, ok)
+		assert.Equal(t, multisigAddress, multisig.MultisigAddress)
+
+		_, _ = w.Write([]byte(`[{"type":"user_transaction","version":"10","hash":"0x1234","success":true,"vm_status":"Executed successfully","gas_used":"12","sender":"0x1","sequence_number":"0","changes":[],"events":[]}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(NetworkConfig{NodeUrl: server.URL, ChainId: 4})
+	assert.NoError(t, err)
+
+	simulation, err := client.SimulateMultisigPayload(owner, multisigAddress, payload, SequenceNumber(0), GasUnitPrice(100), EstimateMaxGasAmount(true))
+	assert.NoError(t, err)
+	assert.True(t, simulation.Success)
+	assert.Equal(t, uint64(12), simulation.GasUsed)
+}
+
+func TestMultisigViews(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/view", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		switch {
+		case bytes.Contains(body, []byte("num_signatures_required")):
+			_, _ = w.Write([]byte(`["2"]`))
+		case bytes.Contains(body, []byte("owners")):
+			_, _ = w.Write([]byte(`[["0x2","0x0000000000000000000000000000000000000000000000000000000000000003"]]`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(NetworkConfig{NodeUrl: server.URL, ChainId: 4})
+	assert.NoError(t, err)
+
+	numSignaturesRequired, err := client.MultisigSignaturesRequired(AccountOne)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), numSignaturesRequired)
+
+	owners, err := client.MultisigOwners(AccountOne)
+	assert.NoError(t, err)
+	assert.Equal(t, []AccountAddress{AccountTwo, AccountThree}, owners)
+}
+
+func TestMultisigViewsEmptyResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(NetworkConfig{NodeUrl: server.URL, ChainId: 4})
+	assert.NoError(t, err)
+
+	// An empty response is an error, not a panic
+	_, err = client.MultisigSignaturesRequired(AccountOne)
+	assert.ErrorContains(t, err, "no values")
+	_, err = client.MultisigOwners(AccountOne)
+	assert.ErrorContains(t, err, "no values")
+}
+
+func TestPredictMultisigAddress(t *testing.T) {
+	// sha3_256(creator || "aptos_framework::multisig_account" || bcs(u64 5) || 0xFF), following
+	// multisig_account::create_multisig_account_seed and account::create_resource_address
+	expected := AccountAddress{}
+	err := expected.ParseStringRelaxed("0x816e993da921f435c9628493c4c52edf513a947d9e2f55b8228e1e2a3e5c057d")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, PredictMultisigAddress(AccountTwo, 5))
+
+	// Different sequence numbers must give different addresses
+	assert.NotEqual(t, PredictMultisigAddress(AccountTwo, 5), PredictMultisigAddress(AccountTwo, 6))
+}
@@ -2,16 +2,19 @@ package aptos
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"math"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/aptos-labs/aptos-go-sdk/api"
@@ -35,12 +38,24 @@ const ContentTypeAptosViewFunctionBcs = "application/x.aptos.view_function+bcs"
 
 // NodeClient is a client for interacting with an Aptos node API
 type NodeClient struct {
-	client  *http.Client      // HTTP client to use for requests
-	baseUrl *url.URL          // Base URL of the node e.g. https://fullnode.testnet.aptoslabs.com/v1
-	chainId uint8             // Chain ID of the network e.g. 2 for Testnet
-	headers map[string]string // Headers to be added to every transaction
+	client      *http.Client      // HTTP client to use for requests
+	baseUrl     *url.URL          // Base URL of the node e.g. https://fullnode.testnet.aptoslabs.com/v1
+	chainId     uint8             // Chain ID of the network e.g. 2 for Testnet
+	chainIdLock sync.RWMutex      // Lock for chainId, which can be set concurrently while building transactions
+	headers     map[string]string // Headers to be added to every transaction
+	ledgerCache *ledgerCache      // Cache for the gas estimate, nil if disabled.  See [NodeClient.WithLedgerCache]
+	respHook    ResponseHook      // Hook called with every raw response, nil if disabled.  See [NodeClient.WithResponseHook]
+	moduleCache *moduleCache      // Cache for module ABIs, nil if disabled.  See [NodeClient.WithModuleABICache]
+	preferBCS   bool              // Request BCS instead of JSON for reads that support it.  See [NodeClient.WithPreferBCS]
+
+	sequenceManagers     map[AccountAddress]*SequenceManager // Shared sequence managers, see [NodeClient.SequenceManagerFor]
+	sequenceManagersLock sync.Mutex                          // Lock for sequenceManagers
 }
 
+// ResponseHook is called with the HTTP method, status code, and raw body of every node API response, including errors.
+// The body must not be modified.
+type ResponseHook func(method string, status int, body []byte)
+
 // NewNodeClient creates a new client for interacting with an Aptos node API
 func NewNodeClient(rpcUrl string, chainId uint8) (*NodeClient, error) {
 	// Set cookie jar so cookie stickiness applies to connections
@@ -92,6 +107,70 @@ func (rc *NodeClient) RemoveHeader(key string) {
 	delete(rc.headers, key)
 }
 
+// WithLedgerCache enables caching of [NodeClient.EstimateGasPrice] for the given TTL, which removes redundant requests
+// when building many transactions.  The chain ID is always cached after it is first fetched.  Returns the same client
+// for chaining.
+//
+//	client.WithLedgerCache(5 * time.Second)
+func (rc *NodeClient) WithLedgerCache(ttl time.Duration) *NodeClient {
+	rc.ledgerCache = &ledgerCache{ttl: ttl}
+	return rc
+}
+
+// WithModuleABICache enables caching of [NodeClient.AccountModule] for the given TTL, which removes redundant requests
+// when repeatedly using the same module's ABI.  Only requests for the latest ledger version are cached.  If a call
+// using a cached ABI fails, e.g. [NodeClient.InspectRawTransaction] on a function added by a module upgrade, the module
+// is evicted so the next call fetches it again.  Returns the same client for chaining.
+//
+//	client.WithModuleABICache(10 * time.Minute)
+func (rc *NodeClient) WithModuleABICache(ttl time.Duration) *NodeClient {
+	rc.moduleCache = newModuleCache(ttl)
+	return rc
+}
+
+// WithPreferBCS makes reads request the BCS representation from the node where it's supported, and decode it into the
+// same Go types as JSON.  BCS is smaller and faster to parse than JSON.  Returns the same client for chaining.
+//
+// Supported by:
+//   - [NodeClient.Account], and the methods built on it e.g. [NodeClient.AccountAuthKey]
+//   - [NodeClient.EstimateGasPrice]
+//
+// Other reads are unaffected, as their JSON types can't be built from BCS without the Move type information.  For raw
+// resources use [NodeClient.AccountResourcesBCS] instead.
+//
+//	client.WithPreferBCS(true)
+func (rc *NodeClient) WithPreferBCS(prefer bool) *NodeClient {
+	rc.preferBCS = prefer
+	return rc
+}
+
+// WithUserAgent sets the User-Agent header on every request, which lets node operators attribute traffic to a service.
+// It defaults to [UserAgentHeaderValue].  Returns the same client for chaining.
+//
+//	client.WithUserAgent("my-service/1.2.0 " + aptos.UserAgentHeaderValue)
+func (rc *NodeClient) WithUserAgent(userAgent string) *NodeClient {
+	rc.SetHeader("User-Agent", userAgent)
+	return rc
+}
+
+// WithResponseHook sets a hook that is called with the raw body of every API response, which is useful for debugging
+// unexpected responses.  Returns the same client for chaining.
+//
+//	client.WithResponseHook(func(method string, status int, body []byte) {
+//		slog.Debug("response", "method", method, "status", status, "body", string(body))
+//	})
+func (rc *NodeClient) WithResponseHook(hook ResponseHook) *NodeClient {
+	rc.respHook = hook
+	return rc
+}
+
+// callResponseHook calls the response hook, if there is one
+func (rc *NodeClient) callResponseHook(method string, status int, body []byte) {
+	if rc.respHook != nil {
+		rc.respHook(method, status, body)
+	}
+}
+
 // Info gets general information about the blockchain
 func (rc *NodeClient) Info() (info NodeInfo, err error) {
 	info, err = Get[NodeInfo](rc, rc.baseUrl.String())
@@ -100,10 +179,24 @@ func (rc *NodeClient) Info() (info NodeInfo, err error) {
 	}
 
 	// Cache the ChainId for later calls, because performance
+	rc.chainIdLock.Lock()
 	rc.chainId = info.ChainId
+	rc.chainIdLock.Unlock()
 	return info, err
 }
 
+// LedgerInfo gets the current state of the blockchain, with every field parsed, see [NodeInfo.LedgerInfo]
+//
+//	info, err := client.LedgerInfo()
+//	expiration := info.LedgerTimestamp.Add(time.Minute)
+func (rc *NodeClient) LedgerInfo() (LedgerInfo, error) {
+	info, err := rc.Info()
+	if err != nil {
+		return LedgerInfo{}, err
+	}
+	return info.LedgerInfo()
+}
+
 // Account gets information about an account for a given address
 //
 // Optionally, a ledgerVersion can be given to get the account state at a specific ledger version
@@ -114,6 +207,13 @@ func (rc *NodeClient) Account(address AccountAddress, ledgerVersion ...uint64) (
 		params.Set("ledger_version", strconv.FormatUint(ledgerVersion[0], 10))
 		au.RawQuery = params.Encode()
 	}
+	if rc.preferBCS {
+		data, err := rc.GetBCS(au.String())
+		if err != nil {
+			return info, fmt.Errorf("get account info api err: %w", err)
+		}
+		return accountInfoFromBCS(data)
+	}
 	info, err = Get[AccountInfo](rc, au.String())
 	if err != nil {
 		return info, fmt.Errorf("get account info api err: %w", err)
@@ -177,6 +277,37 @@ func (rc *NodeClient) AccountResourcesBCS(address AccountAddress, ledgerVersion
 	return
 }
 
+// AccountModule fetches a single module's bytecode and ABI from on-chain state.
+// Optionally, a ledgerVersion can be given to get the module at a specific ledger version
+//
+// If the module ABI cache is enabled with [NodeClient.WithModuleABICache], the latest module is cached for the TTL, and
+// the returned module must not be modified.
+func (rc *NodeClient) AccountModule(address AccountAddress, moduleName string, ledgerVersion ...uint64) (data *api.MoveBytecode, err error) {
+	useCache := rc.moduleCache != nil && len(ledgerVersion) == 0
+	if useCache {
+		if module, ok := rc.moduleCache.module(address, moduleName); ok {
+			return module, nil
+		}
+	}
+	au := rc.baseUrl.JoinPath("accounts", address.String(), "module", moduleName)
+	if len(ledgerVersion) > 0 {
+		params := url.Values{}
+		params.Set("ledger_version", strconv.FormatUint(ledgerVersion[0], 10))
+		au.RawQuery = params.Encode()
+	}
+	data, err = Get[*api.MoveBytecode](rc, au.String())
+	if err != nil {
+		if useCache {
+			rc.moduleCache.invalidate(address, moduleName)
+		}
+		return nil, fmt.Errorf("get module api err: %w", err)
+	}
+	if useCache {
+		rc.moduleCache.setModule(address, moduleName, data)
+	}
+	return data, nil
+}
+
 // TransactionByHash gets info on a transaction
 // The transaction may be pending or recently committed.  If the transaction is a [api.PendingTransaction], then it is
 // still in the mempool.  If the transaction is any other type, it has been committed.
@@ -202,6 +333,46 @@ func (rc *NodeClient) TransactionByHash(txnHash string) (data *api.Transaction,
 	return data, nil
 }
 
+// TxnStatus is the status of a submitted transaction, as known by the node.  See [NodeClient.TransactionStatus]
+type TxnStatus uint8
+
+const (
+	TxnStatusNotFound  TxnStatus = iota // TxnStatusNotFound the node doesn't know of the transaction, it may have been dropped or not reached this node yet
+	TxnStatusPending                    // TxnStatusPending the transaction is in the node's mempool, but not committed yet
+	TxnStatusCommitted                  // TxnStatusCommitted the transaction is committed on-chain, it may have succeeded or failed
+)
+
+// String returns a human-readable name for the status
+func (status TxnStatus) String() string {
+	switch status {
+	case TxnStatusNotFound:
+		return "not_found"
+	case TxnStatusPending:
+		return "pending"
+	case TxnStatusCommitted:
+		return "committed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(status))
+	}
+}
+
+// TransactionStatus gets the status of a transaction by hash, without waiting for it to be committed.  This is a
+// lighter-weight check than [NodeClient.WaitForTransaction], e.g. for displaying progress.
+func (rc *NodeClient) TransactionStatus(txnHash string) (status TxnStatus, err error) {
+	txn, err := rc.TransactionByHash(txnHash)
+	if err != nil {
+		var httpErr *HttpError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return TxnStatusNotFound, nil
+		}
+		return TxnStatusNotFound, err
+	}
+	if txn.Type == api.TransactionVariantPending {
+		return TxnStatusPending, nil
+	}
+	return TxnStatusCommitted, nil
+}
+
 // TransactionByVersion gets info on a transaction by version number
 // The transaction will have been committed.  The response will not be of the type [api.PendingTransaction].
 func (rc *NodeClient) TransactionByVersion(version uint64) (data *api.CommittedTransaction, err error) {
@@ -378,6 +549,70 @@ func (rc *NodeClient) PollForTransactions(txnHashes []string, options ...any) er
 	return nil
 }
 
+// PollMaxPeriod is an option to WaitForCondition, the longest period to back off to between polls
+type PollMaxPeriod time.Duration
+
+// WaitForCondition polls check until it returns true, an error, or the context is cancelled.  This is useful to wait
+// for on-chain state rather than a transaction, such as a balance reaching a threshold.
+//
+// check is called immediately, then the period between calls doubles after each call, up to the max period.
+//
+// Optional arguments:
+//   - PollPeriod: time.Duration, how long to wait before the second poll. Default 100ms.
+//   - PollMaxPeriod: time.Duration, the longest time to wait between polls. Default 1s.
+//   - PollTimeout: time.Duration, how long to wait for the condition. Default 10s.
+//
+// PollPeriod and PollMaxPeriod must be positive.  On timeout, the returned error wraps [context.DeadlineExceeded].
+//
+//	err := WaitForCondition(ctx, func(ctx context.Context) (bool, error) {
+//		balance, err := client.AccountAPTBalance(address)
+//		return balance >= 100_000_000, err
+//	}, PollTimeout(30*time.Second))
+func WaitForCondition(ctx context.Context, check func(context.Context) (bool, error), options ...any) error {
+	period := 100 * time.Millisecond
+	maxPeriod := time.Second
+	timeout := 10 * time.Second
+	for i, arg := range options {
+		switch value := arg.(type) {
+		case PollPeriod:
+			period = time.Duration(value)
+		case PollMaxPeriod:
+			maxPeriod = time.Duration(value)
+		case PollTimeout:
+			timeout = time.Duration(value)
+		default:
+			return fmt.Errorf("WaitForCondition arg %d bad type %T", i+1, arg)
+		}
+	}
+	// A zero period would poll the node in a busy loop
+	if period <= 0 || maxPeriod <= 0 {
+		return fmt.Errorf("WaitForCondition poll periods must be positive, got PollPeriod %s and PollMaxPeriod %s", period, maxPeriod)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("WaitForCondition stopped waiting: %w", ctx.Err())
+		case <-timer.C:
+		}
+
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer.Reset(period)
+		period = min(period*2, maxPeriod)
+	}
+}
+
 // Transactions Get recent transactions.
 //
 // Arguments:
@@ -407,6 +642,345 @@ func (rc *NodeClient) AccountTransactions(account AccountAddress, start *uint64,
 	})
 }
 
+// SubscribeAccountTransactions streams new transactions sent by the account, until the context is cancelled.  Only
+// transactions committed after subscribing are sent, in sequence number order.  The channel is closed when the
+// context is cancelled.
+//
+// Only transactions where the account is the sender are included.  Incoming transfers are sent by other accounts, so
+// they never show up here; to receive them, use [NodeClient.SubscribeIndexedAccountTransactions].
+//
+// The node API doesn't support streaming, so this polls the account's transactions.  Accepts option PollPeriod,
+// which defaults to 1 second.  Errors while polling are sent on the channel, and polling continues afterward.
+func (rc *NodeClient) SubscribeAccountTransactions(ctx context.Context, account AccountAddress, options ...any) (<-chan ConcResponse[*api.CommittedTransaction], error) {
+	period, _, err := getTransactionPollOptions(time.Second, 0, options...)
+	if err != nil {
+		return nil, err
+	}
+	nextSequenceNumber, err := rc.accountSequenceNumberOrZero(account)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(chan ConcResponse[*api.CommittedTransaction])
+	go func() {
+		defer close(results)
+		send := func(response ConcResponse[*api.CommittedTransaction]) bool {
+			select {
+			case results <- response:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			txns, err := rc.accountTransactionsInner(account, &nextSequenceNumber, nil)
+			var httpErr *HttpError
+			if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+				// The account doesn't exist yet
+				continue
+			} else if err != nil {
+				if !send(ConcResponse[*api.CommittedTransaction]{Err: err}) {
+					return
+				}
+				continue
+			}
+			for _, txn := range txns {
+				userTxn, err := txn.UserTransaction()
+				if err == nil {
+					nextSequenceNumber = userTxn.SequenceNumber + 1
+				}
+				if !send(ConcResponse[*api.CommittedTransaction]{Result: txn}) {
+					return
+				}
+			}
+		}
+	}()
+	return results, nil
+}
+
+// indexedAccountTransactionsLimit is the most transaction versions requested from the indexer per poll
+const indexedAccountTransactionsLimit = 100
+
+// SubscribeIndexedAccountTransactions streams new transactions involving the account, sent or received, until the
+// context is cancelled.  Only transactions committed after subscribing are sent, in version order.  The channel is
+// closed when the context is cancelled.
+//
+// The node only lists transactions sent by an account, so this polls the indexer's account_transactions for new
+// versions, and fetches each transaction from the node.  Transactions are sent once the indexer has processed them, so
+// they may lag behind [NodeClient.SubscribeAccountTransactions].
+//
+// Accepts option PollPeriod, which defaults to 1 second.  Errors while polling are sent on the channel, and polling
+// continues afterward from the first transaction not yet sent.
+func (rc *NodeClient) SubscribeIndexedAccountTransactions(ctx context.Context, indexer *IndexerClient, account AccountAddress, options ...any) (<-chan ConcResponse[*api.CommittedTransaction], error) {
+	period, _, err := getTransactionPollOptions(time.Second, 0, options...)
+	if err != nil {
+		return nil, err
+	}
+	info, err := rc.Info()
+	if err != nil {
+		return nil, err
+	}
+	nextVersion := info.LedgerVersion() + 1
+
+	results := make(chan ConcResponse[*api.CommittedTransaction])
+	go func() {
+		defer close(results)
+		send := func(response ConcResponse[*api.CommittedTransaction]) bool {
+			select {
+			case results <- response:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			versions, err := indexer.GetAccountTransactionVersions(account, nextVersion, indexedAccountTransactionsLimit)
+			if err != nil {
+				if !send(ConcResponse[*api.CommittedTransaction]{Err: err}) {
+					return
+				}
+				continue
+			}
+			for _, version := range versions {
+				txn, err := rc.TransactionByVersion(version)
+				if err != nil {
+					// Retry from this version on the next poll
+					if !send(ConcResponse[*api.CommittedTransaction]{Err: err}) {
+						return
+					}
+					break
+				}
+				nextVersion = version + 1
+				if !send(ConcResponse[*api.CommittedTransaction]{Result: txn}) {
+					return
+				}
+			}
+		}
+	}()
+	return results, nil
+}
+
+// StreamBlockTransactions streams every transaction in the blocks from startHeight to endHeight inclusive, in order,
+// fetching each block as it is needed.  If endHeight is 0, it follows the head of the chain until the context is
+// cancelled.  The channel is closed once the last block is sent, or the context is cancelled.
+//
+// Accepts option PollPeriod, which defaults to 1 second.  When following the chain, this is how often to check for new
+// blocks.  Errors while fetching are sent on the channel, and the same block is retried after the poll period.
+func (rc *NodeClient) StreamBlockTransactions(ctx context.Context, startHeight uint64, endHeight uint64, options ...any) (<-chan ConcResponse[*api.CommittedTransaction], error) {
+	period, _, err := getTransactionPollOptions(time.Second, 0, options...)
+	if err != nil {
+		return nil, err
+	}
+	if endHeight != 0 && endHeight < startHeight {
+		return nil, fmt.Errorf("end height %d is before start height %d", endHeight, startHeight)
+	}
+
+	results := make(chan ConcResponse[*api.CommittedTransaction])
+	go func() {
+		defer close(results)
+		send := func(response ConcResponse[*api.CommittedTransaction]) bool {
+			select {
+			case results <- response:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		wait := func() bool {
+			timer := time.NewTimer(period)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		headHeight := uint64(0)
+		for height := startHeight; endHeight == 0 || height <= endHeight; {
+			if ctx.Err() != nil {
+				return
+			}
+
+			// When following the chain, wait for the block to exist
+			if endHeight == 0 && height > headHeight {
+				info, err := rc.Info()
+				if err != nil {
+					if !send(ConcResponse[*api.CommittedTransaction]{Err: err}) || !wait() {
+						return
+					}
+					continue
+				}
+				headHeight = info.BlockHeight()
+				if height > headHeight {
+					if !wait() {
+						return
+					}
+					continue
+				}
+			}
+
+			block, err := rc.BlockByHeight(height, true)
+			if err != nil {
+				if !send(ConcResponse[*api.CommittedTransaction]{Err: err}) || !wait() {
+					return
+				}
+				continue
+			}
+			for _, txn := range block.Transactions {
+				if !send(ConcResponse[*api.CommittedTransaction]{Result: txn}) {
+					return
+				}
+			}
+			height++
+		}
+	}()
+	return results, nil
+}
+
+// StreamStart is an option to [NodeClient.StreamAccountTransactions], the sequence number to start from
+type StreamStart uint64
+
+// StreamLimit is an option to [NodeClient.StreamAccountTransactions], the total number of transactions to send
+type StreamLimit uint64
+
+// StreamDescending is an option to [NodeClient.StreamAccountTransactions], to send the newest transactions first
+type StreamDescending bool
+
+// StreamAccountTransactions streams the transactions sent by the account, fetching a page at a time as they are read.
+// The channel is closed once there are no more transactions, the limit is reached, or the context is cancelled, so
+// cancelling the context stops any further fetching.
+//
+// Accepts options:
+//   - StreamStart, the sequence number to start from.  Default is the first transaction, or the latest when descending
+//   - StreamLimit, the total number of transactions to send.  Default is all of them
+//   - StreamDescending, to send from newest to oldest.  Default is oldest to newest
+//
+// Unlike [NodeClient.SubscribeAccountTransactions], this only reads the existing history, and does not wait for new
+// transactions.  An error while fetching is sent on the channel, and then the channel is closed.
+func (rc *NodeClient) StreamAccountTransactions(ctx context.Context, account AccountAddress, options ...any) (<-chan ConcResponse[*api.CommittedTransaction], error) {
+	const pageSize = uint64(100)
+	var start *uint64
+	remaining := uint64(math.MaxUint64)
+	descending := false
+	for i, arg := range options {
+		switch value := arg.(type) {
+		case StreamStart:
+			startValue := uint64(value)
+			start = &startValue
+		case StreamLimit:
+			remaining = uint64(value)
+		case StreamDescending:
+			descending = bool(value)
+		default:
+			return nil, fmt.Errorf("StreamAccountTransactions arg [%d] unknown option type %T", i+1, arg)
+		}
+	}
+
+	// Descending needs to know where the history ends, which is the last sequence number
+	next := uint64(0)
+	if descending {
+		sequenceNumber, err := rc.accountSequenceNumberOrZero(account)
+		if err != nil {
+			return nil, err
+		}
+		if sequenceNumber == 0 {
+			remaining = 0
+		} else {
+			next = sequenceNumber - 1
+			if start != nil && *start < next {
+				next = *start
+			}
+		}
+	} else if start != nil {
+		next = *start
+	}
+
+	results := make(chan ConcResponse[*api.CommittedTransaction])
+	go func() {
+		defer close(results)
+		send := func(response ConcResponse[*api.CommittedTransaction]) bool {
+			select {
+			case results <- response:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for remaining > 0 && ctx.Err() == nil {
+			pageLen := min(pageSize, remaining)
+			pageStart := next
+			if descending {
+				pageLen = min(pageLen, next+1)
+				pageStart = next + 1 - pageLen
+			}
+
+			txns, err := rc.accountTransactionsInner(account, &pageStart, &pageLen)
+			if err != nil {
+				send(ConcResponse[*api.CommittedTransaction]{Err: err})
+				return
+			}
+			numTxns := min(uint64(len(txns)), pageLen)
+			for i := range numTxns {
+				txn := txns[i]
+				if descending {
+					txn = txns[numTxns-1-i]
+				}
+				if !send(ConcResponse[*api.CommittedTransaction]{Result: txn}) {
+					return
+				}
+			}
+			remaining -= numTxns
+
+			if descending {
+				if pageStart == 0 {
+					return
+				}
+				next = pageStart - 1
+			} else {
+				// A short page is the end of the history
+				if numTxns < pageLen {
+					return
+				}
+				next += numTxns
+			}
+		}
+	}()
+	return results, nil
+}
+
+// accountSequenceNumberOrZero returns the sequence number of the account, or 0 if the account doesn't exist yet
+func (rc *NodeClient) accountSequenceNumberOrZero(account AccountAddress) (uint64, error) {
+	info, err := rc.Account(account)
+	var httpErr *HttpError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return info.SequenceNumber()
+}
+
 // handleTransactions is a helper function for fetching transactions
 //
 // It will fetch the transactions from the node in a single request if possible, otherwise it will fetch them concurrently.
@@ -554,7 +1128,47 @@ func (rc *NodeClient) SubmitTransaction(signedTxn *SignedTransaction) (data *api
 	if err != nil {
 		return
 	}
-	bodyReader := bytes.NewReader(sblob)
+	return rc.SubmitTransactionBCS(sblob)
+}
+
+// SubmitTransactionIdempotent submits a signed transaction, unless the node already knows of it, so it is safe to retry.
+// Resubmitting a transaction normally fails with a confusing sequence number error, instead this returns the status of
+// the existing transaction.
+//
+// The hash of the transaction is returned to wait on e.g. with [NodeClient.WaitForTransaction].  The status is
+// [TxnStatusPending] if it was just submitted, otherwise it is the status of the existing transaction.
+//
+// Note that a node only knows of a pending transaction if it is in its own mempool.
+func (rc *NodeClient) SubmitTransactionIdempotent(signedTxn *SignedTransaction) (hash string, status TxnStatus, err error) {
+	hash, err = signedTxn.Hash()
+	if err != nil {
+		return "", TxnStatusNotFound, err
+	}
+	status, err = rc.TransactionStatus(hash)
+	if err != nil {
+		return hash, status, err
+	}
+	if status != TxnStatusNotFound {
+		return hash, status, nil
+	}
+
+	_, submitErr := rc.SubmitTransaction(signedTxn)
+	if submitErr == nil {
+		return hash, TxnStatusPending, nil
+	}
+
+	// Another submission may have raced this one, in which case it's not an error
+	status, err = rc.TransactionStatus(hash)
+	if err != nil || status == TxnStatusNotFound {
+		return hash, TxnStatusNotFound, submitErr
+	}
+	return hash, status, nil
+}
+
+// SubmitTransactionBCS submits an already BCS serialized [SignedTransaction] to the network, without decoding it.  This
+// is useful for relaying transactions signed elsewhere, such as by a signing server.
+func (rc *NodeClient) SubmitTransactionBCS(signedTxnBytes []byte) (data *api.SubmitTransactionResponse, err error) {
+	bodyReader := bytes.NewReader(signedTxnBytes)
 	au := rc.baseUrl.JoinPath("transactions")
 	data, err = Post[*api.SubmitTransactionResponse](rc, au.String(), ContentTypeAptosSignedTxnBcs, bodyReader)
 	if err != nil {
@@ -590,9 +1204,23 @@ type EstimateMaxGasAmount bool
 // EstimatePrioritizedGasUnitPrice estimates the prioritized gas unit price for a transaction
 type EstimatePrioritizedGasUnitPrice bool
 
+// SimulateMaxGas simulates a transaction with the given max gas amount, instead of the one in the transaction.  This
+// takes precedence over [EstimateMaxGasAmount], and is useful to check whether a transaction succeeds at a lower limit.
+type SimulateMaxGas uint64
+
+// SimulateGasUnitPrice simulates a transaction with the given gas unit price, instead of the one in the transaction.
+// This takes precedence over [EstimateGasUnitPrice] and [EstimatePrioritizedGasUnitPrice].
+type SimulateGasUnitPrice uint64
+
 // SimulateTransaction simulates a transaction
 //
-// TODO: This needs to support RawTransactionWithData
+// For MultiAgent and FeePayer transactions use [NodeClient.SimulateFeePayerTransaction]
+//
+// Accepts options:
+//   - [EstimateGasUnitPrice], [EstimateMaxGasAmount], and [EstimatePrioritizedGasUnitPrice] to have the node estimate
+//     gas values
+//   - [SimulateMaxGas] and [SimulateGasUnitPrice] to simulate with specific gas values
+//
 // TODO: Support multikey simulation
 func (rc *NodeClient) SimulateTransaction(rawTxn *RawTransaction, sender TransactionSigner, options ...any) (data []*api.UserTransaction, err error) {
 	// build authenticator for simulation
@@ -605,21 +1233,9 @@ func (rc *NodeClient) SimulateTransaction(rawTxn *RawTransaction, sender Transac
 	}
 	auth := sender.SimulationAuthenticator()
 
-	// generate signed transaction for simulation (with zero signature)
-	signedTxn, err := rawTxn.SignedTransactionWithAuthenticator(auth)
-	if err != nil {
-		return nil, err
-	}
-
-	sblob, err := bcs.Serialize(signedTxn)
-	if err != nil {
-		return
-	}
-	bodyReader := bytes.NewReader(sblob)
-	au := rc.baseUrl.JoinPath("transactions/simulate")
-
 	// parse simulate tx options
 	params := url.Values{}
+	var maxGas, gasUnitPrice *uint64
 	for i, arg := range options {
 		switch value := arg.(type) {
 		case EstimateGasUnitPrice:
@@ -628,11 +1244,107 @@ func (rc *NodeClient) SimulateTransaction(rawTxn *RawTransaction, sender Transac
 			params.Set("estimate_max_gas_amount", strconv.FormatBool(bool(value)))
 		case EstimatePrioritizedGasUnitPrice:
 			params.Set("estimate_prioritized_gas_unit_price", strconv.FormatBool(bool(value)))
+		case SimulateMaxGas:
+			maxGas = (*uint64)(&value)
+		case SimulateGasUnitPrice:
+			gasUnitPrice = (*uint64)(&value)
 		default:
 			err = fmt.Errorf("SimulateTransaction arg %d bad type %T", i+1, arg)
 			return
 		}
 	}
+
+	// Overrides apply to a copy, so the caller's transaction can still be signed as is
+	if maxGas != nil || gasUnitPrice != nil {
+		overridden := *rawTxn
+		if maxGas != nil {
+			overridden.MaxGasAmount = *maxGas
+			params.Del("estimate_max_gas_amount")
+		}
+		if gasUnitPrice != nil {
+			overridden.GasUnitPrice = *gasUnitPrice
+			params.Del("estimate_gas_unit_price")
+			params.Del("estimate_prioritized_gas_unit_price")
+		}
+		rawTxn = &overridden
+	}
+
+	// generate signed transaction for simulation (with zero signature)
+	signedTxn, err := rawTxn.SignedTransactionWithAuthenticator(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	return rc.simulateSignedTransaction(signedTxn, params)
+}
+
+// SimulateFeePayerTransaction simulates a MultiAgent or FeePayer transaction using only the public keys of the parties
+//
+// Simulation authenticators are built with empty signatures, so no party needs to sign before simulating.  The gas
+// unit price and max gas amount are always estimated by the node.  For a MultiAgent transaction, feePayerPubKey must
+// be nil.  secondarySigners must be in the same order as the secondary signer addresses in the transaction.
+//
+//	rawTxn, _ := client.BuildTransactionMultiAgent(alice.AccountAddress(), txnPayload, FeePayer(&sponsor.Address))
+//	simResponse, err := client.SimulateFeePayerTransaction(rawTxn, alice.PubKey(), sponsor.PubKey())
+func (rc *NodeClient) SimulateFeePayerTransaction(rawTxn *RawTransactionWithData, senderPubKey crypto.PublicKey, feePayerPubKey crypto.PublicKey, secondarySigners ...crypto.PublicKey) (data *api.UserTransaction, err error) {
+	senderAuth, err := crypto.SimulationAuthenticatorFromPublicKey(senderPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("sender simulation authenticator err: %w", err)
+	}
+	secondaryAuths := make([]crypto.AccountAuthenticator, len(secondarySigners))
+	for i, pubKey := range secondarySigners {
+		auth, err := crypto.SimulationAuthenticatorFromPublicKey(pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("secondary signer %d simulation authenticator err: %w", i, err)
+		}
+		secondaryAuths[i] = *auth
+	}
+
+	var signedTxn *SignedTransaction
+	var ok bool
+	switch rawTxn.Variant {
+	case MultiAgentRawTransactionWithDataVariant:
+		if feePayerPubKey != nil {
+			return nil, errors.New("fee payer public key provided for a transaction without a fee payer")
+		}
+		signedTxn, ok = rawTxn.ToMultiAgentSignedTransaction(senderAuth, secondaryAuths)
+	case MultiAgentWithFeePayerRawTransactionWithDataVariant:
+		if feePayerPubKey == nil {
+			return nil, errors.New("fee payer public key required for a fee payer transaction")
+		}
+		feePayerAuth, err := crypto.SimulationAuthenticatorFromPublicKey(feePayerPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("fee payer simulation authenticator err: %w", err)
+		}
+		signedTxn, ok = rawTxn.ToFeePayerSignedTransaction(senderAuth, feePayerAuth, secondaryAuths)
+	default:
+		return nil, fmt.Errorf("unknown RawTransactionWithData variant %d", rawTxn.Variant)
+	}
+	if !ok {
+		return nil, errors.New("failed to build simulation transaction")
+	}
+
+	params := url.Values{}
+	params.Set("estimate_gas_unit_price", "true")
+	params.Set("estimate_max_gas_amount", "true")
+	simulated, err := rc.simulateSignedTransaction(signedTxn, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(simulated) == 0 {
+		return nil, errors.New("simulate transaction api returned no transactions")
+	}
+	return simulated[0], nil
+}
+
+// simulateSignedTransaction posts a signed transaction with simulation authenticators to the simulate endpoint
+func (rc *NodeClient) simulateSignedTransaction(signedTxn *SignedTransaction, params url.Values) (data []*api.UserTransaction, err error) {
+	sblob, err := bcs.Serialize(signedTxn)
+	if err != nil {
+		return
+	}
+	bodyReader := bytes.NewReader(sblob)
+	au := rc.baseUrl.JoinPath("transactions/simulate")
 	if len(params) != 0 {
 		au.RawQuery = params.Encode()
 	}
@@ -646,8 +1358,11 @@ func (rc *NodeClient) SimulateTransaction(rawTxn *RawTransaction, sender Transac
 }
 
 // GetChainId gets the chain ID of the network
+//
+// The chain ID never changes for a network, so it is only fetched once and then cached
 func (rc *NodeClient) GetChainId() (chainId uint8, err error) {
-	if rc.chainId == 0 {
+	chainId = rc.cachedChainId()
+	if chainId == 0 {
 		// Calling Info will cache the ChainId
 		info, err := rc.Info()
 		if err != nil {
@@ -655,7 +1370,55 @@ func (rc *NodeClient) GetChainId() (chainId uint8, err error) {
 		}
 		return info.ChainId, nil
 	}
-	return rc.chainId, nil
+	return chainId, nil
+}
+
+// ErrChainMismatch is matched by [ChainMismatchError] with [errors.Is]
+var ErrChainMismatch = errors.New("chain id mismatch")
+
+// ChainMismatchError is returned by [NodeClient.VerifyNetwork] when the node is on a different network than expected,
+// e.g. a client configured for mainnet pointed at a testnet node
+type ChainMismatchError struct {
+	ExpectedChainId uint8 // ExpectedChainId is the chain ID the client is configured for
+	ActualChainId   uint8 // ActualChainId is the chain ID reported by the node
+}
+
+// Error returns a description of the mismatch, including both chain IDs
+//
+// Implements:
+//   - [error]
+func (e *ChainMismatchError) Error() string {
+	return fmt.Sprintf("%s: expected chain id %d, but node reported chain id %d", ErrChainMismatch, e.ExpectedChainId, e.ActualChainId)
+}
+
+// Is allows matching with [ErrChainMismatch]
+func (e *ChainMismatchError) Is(target error) bool {
+	return target == ErrChainMismatch
+}
+
+// VerifyNetwork checks that the node reports the expected chain ID, and returns a [*ChainMismatchError] if it doesn't.
+// This is useful to call once on startup, to catch a client pointed at the wrong network.
+//
+//	err := client.VerifyNetwork(MainnetConfig.ChainId)
+//	if errors.Is(err, ErrChainMismatch) {
+//		// wrong node URL for the network
+//	}
+func (rc *NodeClient) VerifyNetwork(expectedChainId uint8) error {
+	info, err := rc.Info()
+	if err != nil {
+		return err
+	}
+	if info.ChainId != expectedChainId {
+		return &ChainMismatchError{ExpectedChainId: expectedChainId, ActualChainId: info.ChainId}
+	}
+	return nil
+}
+
+// cachedChainId returns the cached chain ID, or 0 if it hasn't been fetched yet
+func (rc *NodeClient) cachedChainId() uint8 {
+	rc.chainIdLock.RLock()
+	defer rc.chainIdLock.RUnlock()
+	return rc.chainId
 }
 
 // MaxGasAmount will set the max gas amount in gas units for a transaction
@@ -667,6 +1430,47 @@ type GasUnitPrice uint64
 // ExpirationSeconds will set the number of seconds from the current time to expire a transaction
 type ExpirationSeconds int64
 
+// ExpireAfter will set the transaction to expire the given duration after the current ledger timestamp.  The ledger
+// timestamp is fetched from the node, so that local clock skew can't cause the transaction to be rejected as expired.
+type ExpireAfter time.Duration
+
+// ExpireAt will set the transaction to expire at the given time
+type ExpireAt time.Time
+
+// transactionExpiration is how the expiration timestamp of a transaction is chosen.  If none of the expiration options
+// are given, it expires [DefaultExpirationSeconds] after the local clock.
+type transactionExpiration struct {
+	seconds     int64         // Seconds after the local clock, from [ExpirationSeconds]
+	after       time.Duration // Duration after the ledger timestamp, from [ExpireAfter]
+	afterLedger bool          // Whether after is set
+	at          uint64        // Absolute timestamp in seconds, from [ExpireAt], or 0 if unset
+}
+
+// parseOption applies an expiration option, returning false if the option is not an expiration option
+func (expiration *transactionExpiration) parseOption(option any) (bool, error) {
+	switch ovalue := option.(type) {
+	case ExpirationSeconds:
+		if ovalue < 0 {
+			return true, errors.New("ExpirationSeconds cannot be less than 0")
+		}
+		*expiration = transactionExpiration{seconds: int64(ovalue)}
+	case ExpireAfter:
+		if ovalue < 0 {
+			return true, errors.New("ExpireAfter cannot be less than 0")
+		}
+		*expiration = transactionExpiration{after: time.Duration(ovalue), afterLedger: true}
+	case ExpireAt:
+		at := time.Time(ovalue).Unix()
+		if at <= 0 {
+			return true, errors.New("ExpireAt must be after the Unix epoch")
+		}
+		*expiration = transactionExpiration{at: uint64(at)}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
 // FeePayer will set the fee payer for a transaction
 type FeePayer *AccountAddress
 
@@ -680,6 +1484,33 @@ type SequenceNumber uint64
 // TODO: This one may want to be removed / renamed?
 type ChainIdOption uint8
 
+// Orderless will build an orderless transaction, which is protected from replay by Nonce instead of the sender's
+// sequence number, so that many transactions from one sender can be submitted concurrently.  The nonce must not be
+// reused by the sender until the transaction expires.
+//
+// Expiry replaces any other expiration option, and is required as the node only accepts orderless transactions that
+// expire soon, at most a minute in the future.
+type Orderless struct {
+	Nonce  uint64
+	Expiry time.Time
+}
+
+// applyOrderless wraps the payload for an orderless transaction, see [Orderless]
+func applyOrderless(orderless *Orderless, payload *TransactionPayload, expiration *transactionExpiration, sequenceNumber *uint64) error {
+	expiry := orderless.Expiry.Unix()
+	if orderless.Expiry.IsZero() || expiry <= 0 {
+		return errors.New("Orderless expiry must be set")
+	}
+	orderlessPayload, err := OrderlessPayload(*payload, orderless.Nonce)
+	if err != nil {
+		return err
+	}
+	*payload = orderlessPayload
+	*expiration = transactionExpiration{at: uint64(expiry)}
+	*sequenceNumber = OrderlessSequenceNumber
+	return nil
+}
+
 // BuildTransaction builds a raw transaction for signing for a single signer
 //
 // For MultiAgent and FeePayer transactions use [NodeClient.BuildTransactionMultiAgent]
@@ -687,46 +1518,64 @@ type ChainIdOption uint8
 // Accepts options:
 //   - [MaxGasAmount]
 //   - [GasUnitPrice]
+//   - [GasPriceTier]
 //   - [ExpirationSeconds]
+//   - [ExpireAfter]
+//   - [ExpireAt]
 //   - [SequenceNumber]
 //   - [ChainIdOption]
+//   - [Orderless]
 func (rc *NodeClient) BuildTransaction(sender AccountAddress, payload TransactionPayload, options ...any) (rawTxn *RawTransaction, err error) {
 
 	maxGasAmount := DefaultMaxGasAmount
 	gasUnitPrice := DefaultGasUnitPrice
-	expirationSeconds := DefaultExpirationSeconds
+	expiration := transactionExpiration{seconds: DefaultExpirationSeconds}
 	sequenceNumber := uint64(0)
 	haveSequenceNumber := false
 	chainId := uint8(0)
 	haveChainId := false
 	haveGasUnitPrice := false
+	gasPriceTier := GasPriceTierNormal
+	var orderless *Orderless
 
 	for opti, option := range options {
+		if ok, expirationErr := expiration.parseOption(option); ok {
+			if expirationErr != nil {
+				return nil, expirationErr
+			}
+			continue
+		}
 		switch ovalue := option.(type) {
 		case MaxGasAmount:
 			maxGasAmount = uint64(ovalue)
 		case GasUnitPrice:
 			gasUnitPrice = uint64(ovalue)
 			haveGasUnitPrice = true
-		case ExpirationSeconds:
-			expirationSeconds = int64(ovalue)
-			if expirationSeconds < 0 {
-				err = errors.New("ExpirationSeconds cannot be less than 0")
-				return nil, err
-			}
+		case GasPriceTier:
+			gasPriceTier = ovalue
 		case SequenceNumber:
 			sequenceNumber = uint64(ovalue)
 			haveSequenceNumber = true
 		case ChainIdOption:
 			chainId = uint8(ovalue)
 			haveChainId = true
+		case Orderless:
+			orderless = &ovalue
 		default:
 			err = fmt.Errorf("BuildTransaction arg [%d] unknown option type %T", opti+4, option)
 			return nil, err
 		}
 	}
 
-	return rc.buildTransactionInner(sender, payload, maxGasAmount, gasUnitPrice, haveGasUnitPrice, expirationSeconds, sequenceNumber, haveSequenceNumber, chainId, haveChainId)
+	if orderless != nil {
+		err = applyOrderless(orderless, &payload, &expiration, &sequenceNumber)
+		if err != nil {
+			return nil, err
+		}
+		haveSequenceNumber = true
+	}
+
+	return rc.buildTransactionInner(sender, payload, maxGasAmount, gasUnitPrice, haveGasUnitPrice, gasPriceTier, expiration, sequenceNumber, haveSequenceNumber, chainId, haveChainId)
 }
 
 // BuildTransactionMultiAgent builds a raw transaction for signing with fee payer or multi-agent
@@ -736,44 +1585,54 @@ func (rc *NodeClient) BuildTransaction(sender AccountAddress, payload Transactio
 // Accepts options:
 //   - [MaxGasAmount]
 //   - [GasUnitPrice]
+//   - [GasPriceTier]
 //   - [ExpirationSeconds]
+//   - [ExpireAfter]
+//   - [ExpireAt]
 //   - [SequenceNumber]
 //   - [ChainIdOption]
+//   - [Orderless]
 //   - [FeePayer]
 //   - [AdditionalSigners]
 func (rc *NodeClient) BuildTransactionMultiAgent(sender AccountAddress, payload TransactionPayload, options ...any) (rawTxnImpl *RawTransactionWithData, err error) {
 
 	maxGasAmount := DefaultMaxGasAmount
 	gasUnitPrice := DefaultGasUnitPrice
-	expirationSeconds := DefaultExpirationSeconds
+	expiration := transactionExpiration{seconds: DefaultExpirationSeconds}
 	sequenceNumber := uint64(0)
 	haveSequenceNumber := false
 	chainId := uint8(0)
 	haveChainId := false
 	haveGasUnitPrice := false
+	gasPriceTier := GasPriceTierNormal
+	var orderless *Orderless
 
 	var feePayer *AccountAddress
 	var additionalSigners []AccountAddress
 
 	for opti, option := range options {
+		if ok, expirationErr := expiration.parseOption(option); ok {
+			if expirationErr != nil {
+				return nil, expirationErr
+			}
+			continue
+		}
 		switch ovalue := option.(type) {
 		case MaxGasAmount:
 			maxGasAmount = uint64(ovalue)
 		case GasUnitPrice:
 			gasUnitPrice = uint64(ovalue)
 			haveGasUnitPrice = true
-		case ExpirationSeconds:
-			expirationSeconds = int64(ovalue)
-			if expirationSeconds < 0 {
-				err = errors.New("ExpirationSeconds cannot be less than 0")
-				return nil, err
-			}
+		case GasPriceTier:
+			gasPriceTier = ovalue
 		case SequenceNumber:
 			sequenceNumber = uint64(ovalue)
 			haveSequenceNumber = true
 		case ChainIdOption:
 			chainId = uint8(ovalue)
 			haveChainId = true
+		case Orderless:
+			orderless = &ovalue
 		case FeePayer:
 			feePayer = ovalue
 		case AdditionalSigners:
@@ -784,8 +1643,16 @@ func (rc *NodeClient) BuildTransactionMultiAgent(sender AccountAddress, payload
 		}
 	}
 
+	if orderless != nil {
+		err = applyOrderless(orderless, &payload, &expiration, &sequenceNumber)
+		if err != nil {
+			return nil, err
+		}
+		haveSequenceNumber = true
+	}
+
 	// Build the base raw transaction
-	rawTxn, err := rc.buildTransactionInner(sender, payload, maxGasAmount, gasUnitPrice, haveGasUnitPrice, expirationSeconds, sequenceNumber, haveSequenceNumber, chainId, haveChainId)
+	rawTxn, err := rc.buildTransactionInner(sender, payload, maxGasAmount, gasUnitPrice, haveGasUnitPrice, gasPriceTier, expiration, sequenceNumber, haveSequenceNumber, chainId, haveChainId)
 	if err != nil {
 		return nil, err
 	}
@@ -817,7 +1684,8 @@ func (rc *NodeClient) buildTransactionInner(
 	maxGasAmount uint64,
 	gasUnitPrice uint64,
 	haveGasUnitPrice bool,
-	expirationSeconds int64,
+	gasPriceTier GasPriceTier,
+	expiration transactionExpiration,
 	sequenceNumber uint64,
 	haveSequenceNumber bool,
 	chainId uint8,
@@ -834,7 +1702,7 @@ func (rc *NodeClient) buildTransactionInner(
 			if innerErr != nil {
 				gasPriceErrChannel <- innerErr
 			} else {
-				gasUnitPrice = gasPriceEstimation.GasEstimate
+				gasUnitPrice = gasPriceEstimation.Price(gasPriceTier)
 				gasPriceErrChannel <- nil
 			}
 			close(gasPriceErrChannel)
@@ -844,7 +1712,8 @@ func (rc *NodeClient) buildTransactionInner(
 	// Fetch ChainId which may be cached
 	var chainIdErrChannel chan error
 	if !haveChainId {
-		if rc.chainId == 0 {
+		chainId = rc.cachedChainId()
+		if chainId == 0 {
 			chainIdErrChannel = make(chan error, 1)
 			go func() {
 				chain, innerErr := rc.GetChainId()
@@ -856,8 +1725,6 @@ func (rc *NodeClient) buildTransactionInner(
 				}
 				close(chainIdErrChannel)
 			}()
-		} else {
-			chainId = rc.chainId
 		}
 	}
 
@@ -884,6 +1751,24 @@ func (rc *NodeClient) buildTransactionInner(
 		}()
 	}
 
+	// Fetch the ledger timestamp for expiration relative to the ledger
+	var ledgerTimestampErrChannel chan error
+	ledgerTimestampSeconds := uint64(0)
+	if expiration.afterLedger {
+		ledgerTimestampErrChannel = make(chan error, 1)
+		go func() {
+			info, innerErr := rc.Info()
+			if innerErr != nil {
+				ledgerTimestampErrChannel <- innerErr
+			} else {
+				// The ledger timestamp is in microseconds
+				ledgerTimestampSeconds = info.LedgerTimestamp() / 1_000_000
+				ledgerTimestampErrChannel <- nil
+			}
+			close(ledgerTimestampErrChannel)
+		}()
+	}
+
 	// TODO: optionally simulate for max gas
 	// Wait on the errors
 	if chainIdErrChannel != nil {
@@ -905,7 +1790,22 @@ func (rc *NodeClient) buildTransactionInner(
 		}
 	}
 
-	expirationTimestampSeconds := uint64(time.Now().Unix() + expirationSeconds)
+	if ledgerTimestampErrChannel != nil {
+		ledgerTimestampErr := <-ledgerTimestampErrChannel
+		if ledgerTimestampErr != nil {
+			return nil, ledgerTimestampErr
+		}
+	}
+
+	var expirationTimestampSeconds uint64
+	switch {
+	case expiration.at != 0:
+		expirationTimestampSeconds = expiration.at
+	case expiration.afterLedger:
+		expirationTimestampSeconds = ledgerTimestampSeconds + uint64(expiration.after/time.Second)
+	default:
+		expirationTimestampSeconds = uint64(time.Now().Unix() + expiration.seconds)
+	}
 
 	// Base raw transaction used for all requests
 	rawTxn = &RawTransaction{
@@ -962,14 +1862,73 @@ func (rc *NodeClient) View(payload *ViewPayload, ledgerVersion ...uint64) (data
 	return data, nil
 }
 
+// viewBatchWorkers is the maximum number of concurrent view function calls made by [NodeClient.ViewBatch]
+const viewBatchWorkers = 8
+
+// ViewBatch calls multiple view functions concurrently with a bounded number of workers
+//
+// The results and errors are returned in the same order as the input payloads.  If the context is cancelled, any
+// payloads that have not yet been sent will have the context's error.
+func (rc *NodeClient) ViewBatch(ctx context.Context, payloads []*ViewPayload, ledgerVersion ...uint64) (results [][]any, errs []error) {
+	results = make([][]any, len(payloads))
+	errs = make([]error, len(payloads))
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range min(viewBatchWorkers, len(payloads)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i], errs[i] = rc.View(payloads[i], ledgerVersion...)
+			}
+		}()
+	}
+
+	for i := range payloads {
+		if ctx.Err() != nil {
+			errs[i] = ctx.Err()
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+		case indices <- i:
+		}
+	}
+	close(indices)
+	wg.Wait()
+	return results, errs
+}
+
 // EstimateGasPrice estimates the gas price given on-chain data
-// TODO: add caching for some period of time
+//
+// If the ledger cache is enabled with [NodeClient.WithLedgerCache], the estimate is cached for the TTL
 func (rc *NodeClient) EstimateGasPrice() (info EstimateGasInfo, err error) {
+	if rc.ledgerCache != nil {
+		if info, ok := rc.ledgerCache.gasEstimate(); ok {
+			return info, nil
+		}
+	}
 	au := rc.baseUrl.JoinPath("estimate_gas_price")
-	info, err = Get[EstimateGasInfo](rc, au.String())
+	if rc.preferBCS {
+		var data []byte
+		data, err = rc.GetBCS(au.String())
+		if err == nil {
+			info, err = estimateGasInfoFromBCS(data)
+		}
+	} else {
+		info, err = Get[EstimateGasInfo](rc, au.String())
+	}
 	if err != nil {
+		if rc.ledgerCache != nil {
+			rc.ledgerCache.invalidate()
+		}
 		return info, fmt.Errorf("estimate gas price err: %w", err)
 	}
+	if rc.ledgerCache != nil {
+		rc.ledgerCache.setGasEstimate(info)
+	}
 	return info, nil
 }
 
@@ -993,6 +1952,101 @@ func (rc *NodeClient) AccountAPTBalance(account AccountAddress, ledgerVersion ..
 	return StrToUint64(values[0].(string))
 }
 
+// AccountBalances fetches the APT balances of many accounts concurrently, with the same bounded number of workers
+// as [NodeClient.ViewBatch].  Balances are in octas, the same as [NodeClient.AccountAPTBalance].
+//
+// The errors are in the same order as the accounts, and only accounts without an error are in the balances.  An
+// account without any APT, including one that doesn't exist on-chain, has a balance of 0 rather than an error, but any
+// other failure e.g. a 5xx or 429 is an error.  If the context is cancelled, any accounts that have not yet been fetched will have the context's error.
+//
+//	balances, errs := client.AccountBalances(ctx, addresses)
+func (rc *NodeClient) AccountBalances(ctx context.Context, accounts []AccountAddress, ledgerVersion ...uint64) (balances map[AccountAddress]uint64, errs []error) {
+	results := make([]uint64, len(accounts))
+	errs = make([]error, len(accounts))
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range min(viewBatchWorkers, len(accounts)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i], errs[i] = rc.accountAPTBalanceOrZero(accounts[i], ledgerVersion...)
+			}
+		}()
+	}
+
+	for i := range accounts {
+		if ctx.Err() != nil {
+			errs[i] = ctx.Err()
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+		case indices <- i:
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	balances = make(map[AccountAddress]uint64, len(accounts))
+	for i, account := range accounts {
+		if errs[i] == nil {
+			balances[account] = results[i]
+		}
+	}
+	return balances, errs
+}
+
+// accountAPTBalanceOrZero fetches the APT balance, treating an account without any APT as a balance of 0.  Older
+// frameworks abort the balance view for an account without a CoinStore, including one that doesn't exist.
+func (rc *NodeClient) accountAPTBalanceOrZero(account AccountAddress, ledgerVersion ...uint64) (uint64, error) {
+	balance, err := rc.AccountAPTBalance(account, ledgerVersion...)
+	if isCoinStoreNotPublished(err) {
+		return 0, nil
+	}
+	return balance, err
+}
+
+// isCoinStoreNotPublished tells if the error is the coin module's abort for an account without a CoinStore
+func isCoinStoreNotPublished(err error) bool {
+	var httpErr *HttpError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	return bytes.Contains(httpErr.Body, []byte("ECOIN_STORE_NOT_PUBLISHED"))
+}
+
+// IsCoinRegistered checks whether the account has registered a CoinStore for the given legacy coin type.
+//
+// An account must register a coin before it can receive it, see [RegisterCoinPayload].
+func (rc *NodeClient) IsCoinRegistered(account AccountAddress, coinType TypeTag, ledgerVersion ...uint64) (registered bool, err error) {
+	accountBytes, err := bcs.Serialize(&account)
+	if err != nil {
+		return false, err
+	}
+	values, err := rc.View(&ViewPayload{Module: ModuleId{
+		Address: AccountOne,
+		Name:    "coin",
+	},
+		Function: "is_account_registered",
+		ArgTypes: []TypeTag{coinType},
+		Args:     [][]byte{accountBytes},
+	}, ledgerVersion...)
+	if err != nil {
+		return false, err
+	}
+	if len(values) == 0 {
+		return false, errors.New("is_account_registered returned no values")
+	}
+	registered, ok := values[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("is_account_registered returned unexpected type %T", values[0])
+	}
+	return registered, nil
+}
+
 // BuildSignAndSubmitTransaction builds, signs, and submits a transaction to the network
 func (rc *NodeClient) BuildSignAndSubmitTransaction(sender TransactionSigner, payload TransactionPayload, options ...any) (data *api.SubmitTransactionResponse, err error) {
 	rawTxn, err := rc.BuildTransaction(sender.AccountAddress(), payload, options...)
@@ -1026,6 +2080,7 @@ func Get[T any](rc *NodeClient, getUrl string) (out T, err error) {
 		return out, err
 	}
 	req.Header.Set(ClientHeader, ClientHeaderValue)
+	req.Header.Set("User-Agent", UserAgentHeaderValue)
 
 	// Set all preset headers
 	for key, value := range rc.headers {
@@ -1039,14 +2094,16 @@ func Get[T any](rc *NodeClient, getUrl string) (out T, err error) {
 	}
 
 	if response.StatusCode >= 400 {
-		err = NewHttpError(response)
-		return out, err
+		httpErr := NewHttpError(response)
+		rc.callResponseHook(req.Method, response.StatusCode, httpErr.Body)
+		return out, httpErr
 	}
 	blob, err := io.ReadAll(response.Body)
 	if err != nil {
 		return out, fmt.Errorf("error getting response data, %w", err)
 	}
 	_ = response.Body.Close()
+	rc.callResponseHook(req.Method, response.StatusCode, blob)
 	err = json.Unmarshal(blob, &out)
 	if err != nil {
 		return out, err
@@ -1062,6 +2119,7 @@ func (rc *NodeClient) GetBCS(getUrl string) (out []byte, err error) {
 	}
 	req.Header.Set("Accept", "application/x-bcs")
 	req.Header.Set(ClientHeader, ClientHeaderValue)
+	req.Header.Set("User-Agent", UserAgentHeaderValue)
 
 	// Set all preset headers
 	for key, value := range rc.headers {
@@ -1074,8 +2132,9 @@ func (rc *NodeClient) GetBCS(getUrl string) (out []byte, err error) {
 		return
 	}
 	if response.StatusCode >= 400 {
-		err = NewHttpError(response)
-		return
+		httpErr := NewHttpError(response)
+		rc.callResponseHook(req.Method, response.StatusCode, httpErr.Body)
+		return nil, httpErr
 	}
 	blob, err := io.ReadAll(response.Body)
 	if err != nil {
@@ -1083,44 +2142,64 @@ func (rc *NodeClient) GetBCS(getUrl string) (out []byte, err error) {
 		return
 	}
 	_ = response.Body.Close()
+	rc.callResponseHook(req.Method, response.StatusCode, blob)
 	return blob, nil
 }
 
 // Post makes a POST request to the endpoint with the given body and parses the response into the given type with JSON
 func Post[T any](rc *NodeClient, postUrl string, contentType string, body io.Reader) (data T, err error) {
+	return postWithHeaders[T](rc, postUrl, contentType, body, nil)
+}
+
+// postWithHeaders is [Post], with extra headers that take precedence over the client's preset headers
+func postWithHeaders[T any](rc *NodeClient, postUrl string, contentType string, body io.Reader, extraHeaders map[string]string) (data T, err error) {
+	blob, err := postBytes(rc, postUrl, contentType, body, extraHeaders)
+	if err != nil {
+		return data, err
+	}
+	err = json.Unmarshal(blob, &data)
+	return data, err
+}
+
+// postBytes is [postWithHeaders], but returns the raw response body e.g. for BCS responses
+func postBytes(rc *NodeClient, postUrl string, contentType string, body io.Reader, extraHeaders map[string]string) (blob []byte, err error) {
 	if body == nil {
 		body = http.NoBody
 	}
 	req, err := http.NewRequest("POST", postUrl, body)
 	if err != nil {
-		return data, err
+		return nil, err
 	}
 	req.Header.Set("Content-Type", contentType)
 	req.Header.Set(ClientHeader, ClientHeaderValue)
+	req.Header.Set("User-Agent", UserAgentHeaderValue)
 
 	// Set all preset headers
 	for key, value := range rc.headers {
 		req.Header.Set(key, value)
 	}
+	for key, value := range extraHeaders {
+		req.Header.Set(key, value)
+	}
 
 	response, err := rc.client.Do(req)
 	if err != nil {
 		err = fmt.Errorf("POST %s, %w", postUrl, err)
-		return data, err
+		return nil, err
 	}
 	if response.StatusCode >= 400 {
-		err = NewHttpError(response)
-		return data, err
+		httpErr := NewHttpError(response)
+		rc.callResponseHook(req.Method, response.StatusCode, httpErr.Body)
+		return nil, httpErr
 	}
-	blob, err := io.ReadAll(response.Body)
+	blob, err = io.ReadAll(response.Body)
 	if err != nil {
 		err = fmt.Errorf("error getting response data, %w", err)
-		return data, err
+		return nil, err
 	}
 	_ = response.Body.Close()
-
-	err = json.Unmarshal(blob, &data)
-	return data, err
+	rc.callResponseHook(req.Method, response.StatusCode, blob)
+	return blob, nil
 }
 
 // ConcResponse is a concurrent response wrapper as a return type for all APIs.  It is meant to specifically be used in channels.
@@ -1,7 +1,21 @@
 package aptos
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
 	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -20,3 +34,1144 @@ func TestPollForTransaction(t *testing.T) {
 	assert.Less(t, dt, 20*time.Millisecond)
 	assert.Error(t, err)
 }
+
+func TestViewBatchCancelled(t *testing.T) {
+	// No requests are made with a cancelled context, so this doesn't need an aptos-node
+	client, err := NewClient(LocalnetConfig)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	payload := &ViewPayload{
+		Module:   ModuleId{Address: AccountOne, Name: "coin"},
+		Function: "balance",
+		ArgTypes: []TypeTag{AptosCoinTypeTag},
+		Args:     [][]byte{AccountOne[:]},
+	}
+	vals, errs := client.ViewBatch(ctx, []*ViewPayload{payload, payload, payload})
+	assert.Len(t, vals, 3)
+	assert.Len(t, errs, 3)
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+}
+
+func TestViewBatch(t *testing.T) {
+	inFlight := atomic.Int32{}
+	maxInFlight := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		// Hold the request, so the workers overlap
+		time.Sleep(10 * time.Millisecond)
+
+		// The index is the only argument of the view function, odd indices fail
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		index := body[len(body)-8]
+		if index%2 == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"abort"}`))
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`["%d"]`, index)))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+
+	payloads := make([]*ViewPayload, 3*viewBatchWorkers)
+	for i := range payloads {
+		index, err := bcs.SerializeU64(uint64(i))
+		assert.NoError(t, err)
+		payloads[i] = &ViewPayload{
+			Module:   ModuleId{Address: AccountThree, Name: "batch"},
+			Function: "value",
+			ArgTypes: []TypeTag{},
+			Args:     [][]byte{index},
+		}
+	}
+	results, errs := client.ViewBatch(context.Background(), payloads)
+	assert.Len(t, results, len(payloads))
+	assert.Len(t, errs, len(payloads))
+	for i := range payloads {
+		if i%2 == 1 {
+			assert.Error(t, errs[i], "payload %d", i)
+			assert.Nil(t, results[i], "payload %d", i)
+		} else {
+			assert.NoError(t, errs[i], "payload %d", i)
+			assert.Equal(t, []any{strconv.Itoa(i)}, results[i], "payload %d", i)
+		}
+	}
+
+	// Requests ran concurrently, but never more than the workers at once
+	assert.Greater(t, maxInFlight.Load(), int32(1))
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(viewBatchWorkers))
+}
+
+func TestAccountBalances(t *testing.T) {
+	existing := AccountTwo
+	missing := AccountThree
+	unregistered := AccountFour
+	unavailable := AccountAddress{0x5}
+	limited := AccountAddress{0x6}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			// The account is the last argument of the view function
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			address := AccountAddress(body[len(body)-32:])
+			switch address {
+			case existing:
+				_, _ = w.Write([]byte(`["12345"]`))
+			case unavailable:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			case limited:
+				w.WriteHeader(http.StatusTooManyRequests)
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message":"Move abort in 0x1::coin: ECOIN_STORE_NOT_PUBLISHED(0x60005): Account hasn't registered CoinStore for CoinType","error_code":"invalid_input","vm_error_code":null}`))
+			}
+			return
+		}
+		// The balance doesn't need the account to be looked up
+		assert.Fail(t, "unexpected request", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	accounts := []AccountAddress{existing, missing, unregistered, unavailable, limited}
+	balances, errs := client.AccountBalances(context.Background(), accounts)
+	assert.Len(t, errs, 5)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.NoError(t, errs[2])
+	// Other failures aren't treated as a missing account
+	var httpErr *HttpError
+	assert.ErrorAs(t, errs[3], &httpErr)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.StatusCode)
+	assert.ErrorAs(t, errs[4], &httpErr)
+	assert.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+	assert.Equal(t, map[AccountAddress]uint64{existing: 12345, missing: 0, unregistered: 0}, balances)
+
+	// Cancelled before any requests
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	balances, errs = client.AccountBalances(ctx, accounts)
+	assert.Empty(t, balances)
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+}
+
+func TestIsCoinRegistered(t *testing.T) {
+	registered := AccountTwo
+	unregistered := AccountThree
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/view", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.True(t, bytes.Contains(body, []byte("is_account_registered")))
+		// The account is the last argument of the view function
+		switch AccountAddress(body[len(body)-32:]) {
+		case registered:
+			_, _ = w.Write([]byte(`[true]`))
+		case unregistered:
+			_, _ = w.Write([]byte(`[false]`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+
+	isRegistered, err := client.IsCoinRegistered(registered, AptosCoinTypeTag)
+	assert.NoError(t, err)
+	assert.True(t, isRegistered)
+
+	isRegistered, err = client.IsCoinRegistered(unregistered, AptosCoinTypeTag)
+	assert.NoError(t, err)
+	assert.False(t, isRegistered)
+
+	_, err = client.IsCoinRegistered(AccountFour, AptosCoinTypeTag)
+	var httpErr *HttpError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusInternalServerError, httpErr.StatusCode)
+}
+
+func TestLedgerCacheGasEstimate(t *testing.T) {
+	requests := atomic.Int32{}
+	fail := atomic.Bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"deprioritized_gas_estimate":100,"gas_estimate":150,"prioritized_gas_estimate":200}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	ttl := 100 * time.Millisecond
+	client.WithLedgerCache(ttl)
+
+	// The second call is served from the cache
+	for range 2 {
+		info, err := client.EstimateGasPrice()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(150), info.GasEstimate)
+	}
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Once expired, an error leaves the cache empty, so the next call goes to the node
+	time.Sleep(ttl)
+	_, ok := client.ledgerCache.gasEstimate()
+	assert.False(t, ok)
+	fail.Store(true)
+	_, err = client.EstimateGasPrice()
+	assert.Error(t, err)
+	_, ok = client.ledgerCache.gasEstimate()
+	assert.False(t, ok)
+	fail.Store(false)
+	_, err = client.EstimateGasPrice()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestModuleABICache(t *testing.T) {
+	requests := atomic.Int32{}
+	fail := atomic.Bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"bytecode":"0x00","abi":{"address":"0x1","name":"coin","friends":[],"exposed_functions":[],"structs":[]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	client.WithModuleABICache(time.Minute)
+
+	// The second call is served from the cache
+	for range 2 {
+		module, err := client.AccountModule(AccountOne, "coin")
+		assert.NoError(t, err)
+		assert.Equal(t, "coin", module.Abi.Name)
+	}
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Other modules and ledger versions aren't served from the cache
+	_, err = client.AccountModule(AccountOne, "other")
+	assert.NoError(t, err)
+	_, err = client.AccountModule(AccountOne, "coin", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+
+	// Failed fetches aren't cached, so the next call goes to the node
+	fail.Store(true)
+	_, err = client.AccountModule(AccountOne, "other2")
+	assert.Error(t, err)
+	_, ok := client.moduleCache.module(AccountOne, "other2")
+	assert.False(t, ok)
+	fail.Store(false)
+	_, err = client.AccountModule(AccountOne, "other2")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), requests.Load())
+}
+
+func TestModuleABICacheEvictsOnFailure(t *testing.T) {
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The module is upgraded after the first fetch, adding the transfer function
+		if requests.Add(1) == 1 {
+			_, _ = w.Write([]byte(`{"bytecode":"0x00","abi":{"address":"0x1","name":"coin","friends":[],"exposed_functions":[],"structs":[]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"bytecode":"0x00","abi":{"address":"0x1","name":"coin","friends":[],"exposed_functions":[{"name":"transfer","visibility":"public","is_entry":true,"is_view":false,"generic_type_params":[],"params":["&signer","u64"],"return":[]}],"structs":[]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	client.WithModuleABICache(time.Minute)
+
+	amount, err := bcs.SerializeU64(100)
+	assert.NoError(t, err)
+	rawTxn := &RawTransaction{
+		Sender: AccountTwo,
+		Payload: TransactionPayload{Payload: &EntryFunction{
+			Module:   ModuleId{Address: AccountOne, Name: "coin"},
+			Function: "transfer",
+			ArgTypes: []TypeTag{},
+			Args:     [][]byte{amount},
+		}},
+		ChainId: 4,
+	}
+
+	// The stale ABI doesn't have the function, so the failure evicts it
+	_, err = client.InspectRawTransaction(rawTxn)
+	assert.Error(t, err)
+	_, ok := client.moduleCache.module(AccountOne, "coin")
+	assert.False(t, ok)
+
+	// The next call fetches the upgraded module, which is then cached
+	summary, err := client.InspectRawTransaction(rawTxn)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), summary.Arguments[0].Value)
+	_, err = client.InspectRawTransaction(rawTxn)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestResponseHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/estimate_gas_price" {
+			_, _ = w.Write([]byte(`{"gas_estimate":150}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	var statuses []int
+	var bodies []string
+	client.WithResponseHook(func(method string, status int, body []byte) {
+		assert.Equal(t, http.MethodGet, method)
+		statuses = append(statuses, status)
+		bodies = append(bodies, string(body))
+	})
+
+	_, err = client.EstimateGasPrice()
+	assert.NoError(t, err)
+	_, err = client.Account(AccountOne)
+	assert.Error(t, err)
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusNotFound}, statuses)
+	assert.Equal(t, []string{`{"gas_estimate":150}`, `{"message":"not found"}`}, bodies)
+}
+
+func TestSubmitTransactionBCS(t *testing.T) {
+	signedTxnBytes := []byte{1, 2, 3, 4}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/transactions", r.URL.Path)
+		assert.Equal(t, ContentTypeAptosSignedTxnBcs, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		// The bytes are relayed untouched
+		assert.Equal(t, signedTxnBytes, body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"hash":"0x1234","sender":"0x1","sequence_number":"0","max_gas_amount":"100","gas_unit_price":"100","expiration_timestamp_secs":"1","payload":{"type":"entry_function_payload","function":"0x1::aptos_account::transfer","type_arguments":[],"arguments":[]},"signature":{"type":"ed25519_signature","public_key":"0x0000000000000000000000000000000000000000000000000000000000000000","signature":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	response, err := client.SubmitTransactionBCS(signedTxnBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1234", response.Hash)
+}
+
+func TestBatchSubmitTransactionPartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transactions/batch", r.URL.Path)
+		// Partial failures are returned with 206 Partial Content
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write([]byte(`{"transaction_failures":[{"error":{"message":"Invalid sequence number","error_code":"vm_error","vm_error_code":3},"transaction_index":1}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	response, err := client.BatchSubmitTransaction([]*SignedTransaction{})
+	assert.NoError(t, err)
+	assert.Len(t, response.TransactionFailures, 1)
+
+	_, failed := response.Failure(0)
+	assert.False(t, failed)
+	failure, failed := response.Failure(1)
+	assert.True(t, failed)
+	assert.Equal(t, "Invalid sequence number", failure.Message)
+	assert.Equal(t, uint64(3), failure.VmErrorCode)
+}
+
+func TestTransactionStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/transactions/by_hash/0x1":
+			_, _ = w.Write([]byte(`{"type":"pending_transaction","hash":"0x1","sender":"0x1","sequence_number":"0","max_gas_amount":"100","gas_unit_price":"100","expiration_timestamp_secs":"1","payload":{"type":"entry_function_payload","function":"0x1::aptos_account::transfer","type_arguments":[],"arguments":[]}}`))
+		case "/transactions/by_hash/0x3":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/transactions/by_hash/0x4":
+			_, _ = w.Write([]byte(`{"type":"user_transaction","version":"10","hash":"0x4","success":true,"vm_status":"Executed successfully","sender":"0x2","sequence_number":"5","changes":[],"events":[]}`))
+		case "/transactions/by_hash/0x5":
+			_, _ = w.Write([]byte(`{"type":"user_transaction","version":"11","hash":"0x5","success":false,"vm_status":"Move abort","sender":"0x2","sequence_number":"6","changes":[],"events":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found","error_code":"transaction_not_found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+
+	status, err := client.TransactionStatus("0x1")
+	assert.NoError(t, err)
+	assert.Equal(t, TxnStatusPending, status)
+
+	status, err = client.TransactionStatus("0x2")
+	assert.NoError(t, err)
+	assert.Equal(t, TxnStatusNotFound, status)
+	assert.Equal(t, "not_found", status.String())
+
+	_, err = client.TransactionStatus("0x3")
+	assert.Error(t, err)
+
+	// Committed transactions are committed whether or not they succeeded
+	status, err = client.TransactionStatus("0x4")
+	assert.NoError(t, err)
+	assert.Equal(t, TxnStatusCommitted, status)
+	assert.Equal(t, "committed", status.String())
+
+	status, err = client.TransactionStatus("0x5")
+	assert.NoError(t, err)
+	assert.Equal(t, TxnStatusCommitted, status)
+}
+
+func TestSubscribeAccountTransactions(t *testing.T) {
+	polls := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/0x2":
+			_, _ = w.Write([]byte(`{"sequence_number":"5","authentication_key":"0x0000000000000000000000000000000000000000000000000000000000000002"}`))
+		case "/accounts/0x2/transactions":
+			// Only transactions after subscribing are requested
+			if polls.Add(1) == 1 {
+				assert.Equal(t, "5", r.URL.Query().Get("start"))
+				_, _ = w.Write([]byte(`[{"type":"user_transaction","version":"10","hash":"0x1234","success":true,"vm_status":"Executed successfully","sender":"0x2","sequence_number":"5","changes":[],"events":[]}]`))
+			} else {
+				assert.Equal(t, "6", r.URL.Query().Get("start"))
+				_, _ = w.Write([]byte(`[]`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	txns, err := client.SubscribeAccountTransactions(ctx, AccountTwo, PollPeriod(time.Millisecond))
+	assert.NoError(t, err)
+
+	txn := <-txns
+	assert.NoError(t, txn.Err)
+	assert.Equal(t, "0x1234", txn.Result.Hash())
+
+	// Wait for another poll, then the channel closes on cancel
+	for polls.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	for range txns {
+	}
+}
+
+func TestSubscribeIndexedAccountTransactions(t *testing.T) {
+	nodeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(`{"chain_id":4,"epoch":"1","ledger_version":"9","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`))
+		case "/transactions/by_version/10":
+			// 0x2 received a transfer from 0x3
+			_, _ = w.Write([]byte(`{"type":"user_transaction","version":"10","hash":"0x1234","success":true,"vm_status":"Executed successfully","sender":"0x3","sequence_number":"0","changes":[],"events":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer nodeServer.Close()
+	polls := atomic.Int32{}
+	indexerServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}{}
+		err := json.NewDecoder(r.Body).Decode(&request)
+		assert.NoError(t, err)
+		assert.Contains(t, request.Query, "$start_version:bigint!")
+		assert.Equal(t, AccountTwo.StringLong(), request.Variables["address"])
+
+		// Only transactions after subscribing are requested, and each is only sent once
+		if polls.Add(1) == 1 {
+			assert.Equal(t, float64(10), request.Variables["start_version"])
+			_, _ = w.Write([]byte(`{"data":{"account_transactions":[{"transaction_version":10}]}}`))
+		} else {
+			assert.Equal(t, float64(11), request.Variables["start_version"])
+			_, _ = w.Write([]byte(`{"data":{"account_transactions":[]}}`))
+		}
+	}))
+	defer indexerServer.Close()
+
+	client, err := NewClient(NetworkConfig{NodeUrl: nodeServer.URL, IndexerUrl: indexerServer.URL, ChainId: 4})
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	txns, err := client.SubscribeAccountTransactions(ctx, AccountTwo, PollPeriod(time.Millisecond))
+	assert.NoError(t, err)
+
+	txn := <-txns
+	assert.NoError(t, txn.Err)
+	assert.Equal(t, "0x1234", txn.Result.Hash())
+
+	for polls.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	for range txns {
+	}
+}
+
+func TestSubscribeAccountTransactionsIgnoresIncoming(t *testing.T) {
+	polls := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/0x2":
+			_, _ = w.Write([]byte(`{"sequence_number":"0","authentication_key":"0x0000000000000000000000000000000000000000000000000000000000000002"}`))
+		case "/accounts/0x2/transactions":
+			// 0x2 has only received a transfer from 0x3, which isn't listed under its own transactions
+			polls.Add(1)
+			_, _ = w.Write([]byte(`[]`))
+		case "/accounts/0x3/transactions":
+			assert.Fail(t, "only the subscribed account should be polled")
+			_, _ = w.Write([]byte(`[{"type":"user_transaction","version":"10","hash":"0x1234","success":true,"vm_status":"Executed successfully","sender":"0x3","sequence_number":"0","changes":[],"events":[]}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	txns, err := client.SubscribeAccountTransactions(ctx, AccountTwo, PollPeriod(time.Millisecond))
+	assert.NoError(t, err)
+
+	// The incoming transfer is never sent
+	for polls.Load() < 3 {
+		select {
+		case txn := <-txns:
+			assert.Fail(t, "unexpected transaction", "%v", txn)
+		case <-time.After(time.Millisecond):
+		}
+	}
+	cancel()
+	for range txns {
+		assert.Fail(t, "unexpected transaction")
+	}
+}
+
+func TestWaitForCondition(t *testing.T) {
+	// Completes once the check passes
+	calls := 0
+	err := WaitForCondition(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	}, PollPeriod(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	// Non-positive periods are rejected rather than busy looping
+	for _, option := range []any{PollPeriod(0), PollMaxPeriod(0), PollPeriod(-time.Second)} {
+		calls = 0
+		err = WaitForCondition(context.Background(), func(ctx context.Context) (bool, error) {
+			calls++
+			return false, nil
+		}, option)
+		assert.Error(t, err)
+		assert.Equal(t, 0, calls)
+	}
+
+	// Errors from the check are returned
+	checkErr := errors.New("check failed")
+	err = WaitForCondition(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, checkErr
+	})
+	assert.ErrorIs(t, err, checkErr)
+
+	// Times out
+	start := time.Now()
+	err = WaitForCondition(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, nil
+	}, PollPeriod(time.Millisecond), PollMaxPeriod(2*time.Millisecond), PollTimeout(20*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// Stops when cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = WaitForCondition(ctx, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// Bad options
+	err = WaitForCondition(context.Background(), func(ctx context.Context) (bool, error) {
+		return true, nil
+	}, 5)
+	assert.Error(t, err)
+}
+
+func TestBuildTransactionExpiration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The ledger timestamp is far behind the local clock, so it can be told apart
+		_, _ = w.Write([]byte(`{"chain_id":4,"epoch":"1","ledger_version":"10","oldest_ledger_version":"0","ledger_timestamp":"1000000000000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	payload := TransactionPayload{Payload: &EntryFunction{Module: ModuleId{Address: AccountOne, Name: "m"}, Function: "f"}}
+	fixed := []any{GasUnitPrice(100), SequenceNumber(1), ChainIdOption(4)}
+
+	rawTxn, err := client.BuildTransaction(AccountOne, payload, append(fixed, ExpireAfter(time.Minute))...)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1_000_000_000+60), rawTxn.ExpirationTimestampSeconds)
+
+	expireAt := time.Unix(2_000_000_000, 0)
+	rawTxn, err = client.BuildTransaction(AccountOne, payload, append(fixed, ExpireAt(expireAt))...)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2_000_000_000), rawTxn.ExpirationTimestampSeconds)
+
+	// The last expiration option wins
+	before := uint64(time.Now().Unix())
+	rawTxn, err = client.BuildTransaction(AccountOne, payload, append(fixed, ExpireAt(expireAt), ExpirationSeconds(10))...)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, rawTxn.ExpirationTimestampSeconds, before+10)
+	assert.LessOrEqual(t, rawTxn.ExpirationTimestampSeconds, uint64(time.Now().Unix())+10)
+
+	_, err = client.BuildTransaction(AccountOne, payload, append(fixed, ExpireAfter(-time.Second))...)
+	assert.Error(t, err)
+	_, err = client.BuildTransactionMultiAgent(AccountOne, payload, append(fixed, ExpireAt(time.Time{}))...)
+	assert.Error(t, err)
+}
+
+func TestBuildTransactionOrderless(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the module is fetched, to inspect the transaction.  The sequence number must not be fetched
+		if r.URL.Path == "/accounts/0x1/module/m" {
+			_, _ = w.Write([]byte(`{"bytecode":"0x00","abi":{"address":"0x1","name":"m","friends":[],"exposed_functions":[{"name":"f","visibility":"public","is_entry":true,"is_view":false,"generic_type_params":[],"params":["&signer"],"return":[]}],"structs":[]}}`))
+			return
+		}
+		t.Errorf("unexpected request %s", r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	entryFunction := &EntryFunction{Module: ModuleId{Address: AccountOne, Name: "m"}, Function: "f", ArgTypes: []TypeTag{}, Args: [][]byte{}}
+	payload := TransactionPayload{Payload: entryFunction}
+	expiry := time.Unix(2_000_000_000, 0)
+
+	rawTxn, err := client.BuildTransaction(AccountOne, payload, GasUnitPrice(100), ChainIdOption(4), ExpirationSeconds(600), Orderless{Nonce: 7, Expiry: expiry})
+	assert.NoError(t, err)
+	assert.Equal(t, OrderlessSequenceNumber, rawTxn.SequenceNumber)
+	assert.Equal(t, uint64(2_000_000_000), rawTxn.ExpirationTimestampSeconds)
+
+	inner, ok := rawTxn.Payload.Payload.(*TransactionInnerPayload)
+	assert.True(t, ok)
+	assert.Equal(t, TransactionExecutableVariantEntryFunction, inner.Executable.Variant)
+	assert.Same(t, entryFunction, inner.Executable.Payload)
+	assert.Nil(t, inner.ExtraConfig.MultisigAddress)
+	assert.Equal(t, uint64(7), *inner.ExtraConfig.ReplayProtectionNonce)
+
+	// Payload, V1, EntryFunction executable, then the entry function
+	payloadBytes, err := bcs.Serialize(&rawTxn.Payload)
+	assert.NoError(t, err)
+	entryFunctionBytes, err := bcs.Serialize(entryFunction)
+	assert.NoError(t, err)
+	expected := append([]byte{0x04, 0x00, 0x01}, entryFunctionBytes...)
+	// V1, no multisig address, and the nonce
+	expected = append(expected, 0x00, 0x00, 0x01, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00)
+	assert.Equal(t, expected, payloadBytes)
+
+	// Signs and round trips
+	sender, err := NewEd25519Account()
+	assert.NoError(t, err)
+	signedTxn, err := rawTxn.SignedTransaction(sender)
+	assert.NoError(t, err)
+	signedBytes, err := bcs.Serialize(signedTxn)
+	assert.NoError(t, err)
+	decoded := &SignedTransaction{}
+	assert.NoError(t, bcs.Deserialize(decoded, signedBytes))
+	assert.Equal(t, uint64(7), *decoded.Transaction.Payload.Payload.(*TransactionInnerPayload).ExtraConfig.ReplayProtectionNonce)
+	assert.NoError(t, decoded.Verify())
+
+	// Round trips through JSON
+	jsonBytes, err := json.Marshal(rawTxn)
+	assert.NoError(t, err)
+	jsonDecoded := &RawTransaction{}
+	assert.NoError(t, json.Unmarshal(jsonBytes, jsonDecoded))
+	assert.Equal(t, rawTxn, jsonDecoded)
+
+	// Can be inspected before signing
+	summary, err := client.InspectRawTransaction(rawTxn)
+	assert.NoError(t, err)
+	assert.Equal(t, TransactionPayloadVariantPayload, summary.PayloadType)
+	assert.Equal(t, "0x1::m::f", summary.Function)
+	assert.Equal(t, uint64(7), *summary.ReplayProtectionNonce)
+	assert.Nil(t, summary.MultisigAddress)
+	assert.Contains(t, summary.String(), "Replay protection nonce: 7\n")
+
+	// Multisig payloads carry the multisig address in the extra config
+	multisigAddress := AccountTwo
+	multiAgentTxn, err := client.BuildTransactionMultiAgent(AccountOne, TransactionPayload{Payload: &Multisig{MultisigAddress: multisigAddress}}, GasUnitPrice(100), ChainIdOption(4), Orderless{Nonce: 8, Expiry: expiry}, FeePayer(&AccountThree))
+	assert.NoError(t, err)
+	inner = multiAgentTxn.Inner.(*MultiAgentWithFeePayerRawTransactionWithData).RawTxn.Payload.Payload.(*TransactionInnerPayload)
+	assert.Equal(t, TransactionExecutableVariantEmpty, inner.Executable.Variant)
+	assert.Equal(t, multisigAddress, *inner.ExtraConfig.MultisigAddress)
+	summary, err = client.InspectRawTransaction(multiAgentTxn.Inner.(*MultiAgentWithFeePayerRawTransactionWithData).RawTxn)
+	assert.NoError(t, err)
+	assert.Equal(t, multisigAddress, *summary.MultisigAddress)
+	assert.Equal(t, uint64(8), *summary.ReplayProtectionNonce)
+	assert.Contains(t, summary.String(), "Multisig address: 0x2\n")
+
+	// Expiry is required
+	_, err = client.BuildTransaction(AccountOne, payload, GasUnitPrice(100), ChainIdOption(4), Orderless{Nonce: 7})
+	assert.Error(t, err)
+}
+
+func TestBuildTransactionGasPriceTier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/estimate_gas_price", r.URL.Path)
+		_, _ = w.Write([]byte(`{"deprioritized_gas_estimate":100,"gas_estimate":150,"prioritized_gas_estimate":1000}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	payload := TransactionPayload{Payload: &EntryFunction{Module: ModuleId{Address: AccountOne, Name: "m"}, Function: "f"}}
+
+	expected := map[GasPriceTier]uint64{
+		GasPriceTierDeprioritized: 100,
+		GasPriceTierNormal:        150,
+		GasPriceTierPrioritized:   1000,
+	}
+	for tier, price := range expected {
+		rawTxn, err := client.BuildTransaction(AccountOne, payload, SequenceNumber(1), ChainIdOption(4), tier)
+		assert.NoError(t, err)
+		assert.Equal(t, price, rawTxn.GasUnitPrice)
+	}
+
+	// An explicit gas unit price is used over the estimate
+	rawTxn, err := client.BuildTransaction(AccountOne, payload, SequenceNumber(1), ChainIdOption(4), GasPriceTierPrioritized, GasUnitPrice(5))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), rawTxn.GasUnitPrice)
+
+	// Missing tiers fall back to the normal estimate
+	assert.Equal(t, uint64(150), EstimateGasInfo{GasEstimate: 150}.Price(GasPriceTierPrioritized))
+}
+
+func TestVerifyNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"chain_id":2,"epoch":"1","ledger_version":"10","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, client.VerifyNetwork(2))
+
+	err = client.VerifyNetwork(1)
+	assert.ErrorIs(t, err, ErrChainMismatch)
+	var mismatchErr *ChainMismatchError
+	assert.ErrorAs(t, err, &mismatchErr)
+	assert.Equal(t, uint8(1), mismatchErr.ExpectedChainId)
+	assert.Equal(t, uint8(2), mismatchErr.ActualChainId)
+}
+
+func TestStreamBlockTransactions(t *testing.T) {
+	head := atomic.Uint64{}
+	head.Store(3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			_, _ = fmt.Fprintf(w, `{"chain_id":4,"epoch":"1","ledger_version":"100","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"%d","git_hash":""}`, head.Load())
+			return
+		}
+		var height uint64
+		if _, err := fmt.Sscanf(r.URL.Path, "/blocks/by_height/%d", &height); err != nil || height > head.Load() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// Each block has two transactions
+		txn := `{"version":"%d","hash":"0x1","state_change_hash":"0x1","event_root_hash":"0x1","state_checkpoint_hash":"0x1","gas_used":"0","success":true,"vm_status":"Executed successfully","accumulator_root_hash":"0x1","changes":[],"timestamp":"1","type":"state_checkpoint_transaction"}`
+		first := height * 2
+		_, _ = fmt.Fprintf(w, `{"block_hash":"0x1","block_height":"%d","block_timestamp":"1","first_version":"%d","last_version":"%d","transactions":[`+txn+`,`+txn+`]}`, height, first, first+1, first, first+1)
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+
+	// A fixed range is streamed in order, then the channel is closed
+	txns, err := client.StreamBlockTransactions(context.Background(), 1, 3, PollPeriod(time.Millisecond))
+	assert.NoError(t, err)
+	versions := make([]uint64, 0)
+	for txn := range txns {
+		assert.NoError(t, txn.Err)
+		versions = append(versions, txn.Result.Version())
+	}
+	assert.Equal(t, []uint64{2, 3, 4, 5, 6, 7}, versions)
+
+	// Following the chain waits for new blocks
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txns, err = client.StreamBlockTransactions(ctx, 3, 0, PollPeriod(time.Millisecond))
+	assert.NoError(t, err)
+	versions = versions[:0]
+	for txn := range txns {
+		assert.NoError(t, txn.Err)
+		versions = append(versions, txn.Result.Version())
+		if len(versions) == 2 {
+			head.Store(4)
+		}
+		if len(versions) == 4 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []uint64{6, 7, 8, 9}, versions)
+
+	// Invalid ranges are rejected
+	_, err = client.StreamBlockTransactions(context.Background(), 3, 1)
+	assert.Error(t, err)
+}
+
+func TestSimulateTransactionGasOverrides(t *testing.T) {
+	sender, err := NewEd25519Account()
+	assert.NoError(t, err)
+
+	var query url.Values
+	var simulated *RawTransaction
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transactions/simulate", r.URL.Path)
+		query = r.URL.Query()
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		signedTxn := &SignedTransaction{}
+		assert.NoError(t, bcs.Deserialize(signedTxn, body))
+		simulated = signedTxn.Transaction
+		_, _ = w.Write([]byte(`[{"type":"user_transaction","version":"10","hash":"0x1234","success":true,"vm_status":"Executed successfully","gas_used":"12","sender":"0x1","sequence_number":"0","changes":[],"events":[]}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	transfer, err := CoinTransferPayload(nil, AccountTwo, 100)
+	assert.NoError(t, err)
+	rawTxn, err := client.BuildTransaction(sender.Address, TransactionPayload{Payload: transfer}, SequenceNumber(0), GasUnitPrice(100), MaxGasAmount(1000))
+	assert.NoError(t, err)
+
+	// By default, the transaction's values are used
+	_, err = client.SimulateTransaction(rawTxn, sender, EstimateGasUnitPrice(true))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), simulated.MaxGasAmount)
+	assert.Equal(t, "true", query.Get("estimate_gas_unit_price"))
+
+	// Overrides replace the values, and the matching estimates
+	_, err = client.SimulateTransaction(rawTxn, sender, EstimateGasUnitPrice(true), EstimateMaxGasAmount(true), SimulateMaxGas(20), SimulateGasUnitPrice(150))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(20), simulated.MaxGasAmount)
+	assert.Equal(t, uint64(150), simulated.GasUnitPrice)
+	assert.False(t, query.Has("estimate_max_gas_amount"))
+	assert.False(t, query.Has("estimate_gas_unit_price"))
+
+	// The caller's transaction is unchanged
+	assert.Equal(t, uint64(1000), rawTxn.MaxGasAmount)
+	assert.Equal(t, uint64(100), rawTxn.GasUnitPrice)
+}
+
+func TestSimulateFeePayerTransaction(t *testing.T) {
+	sender, err := NewEd25519Account()
+	assert.NoError(t, err)
+	secondary, err := NewEd25519Account()
+	assert.NoError(t, err)
+	sponsor, err := NewEd25519Account()
+	assert.NoError(t, err)
+
+	var query url.Values
+	var simulated *SignedTransaction
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transactions/simulate", r.URL.Path)
+		query = r.URL.Query()
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		simulated = &SignedTransaction{}
+		assert.NoError(t, bcs.Deserialize(simulated, body))
+		_, _ = w.Write([]byte(`[{"type":"user_transaction","version":"10","hash":"0x1234","success":true,"vm_status":"Executed successfully","gas_used":"12","sender":"0x1","sequence_number":"0","changes":[],"events":[]}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	transfer, err := CoinTransferPayload(nil, AccountTwo, 100)
+	assert.NoError(t, err)
+	payload := TransactionPayload{Payload: transfer}
+
+	// emptySignature checks the authenticator is the signer's key with a zeroed signature
+	emptySignature := func(auth *crypto.AccountAuthenticator, signer *Account) {
+		ed25519Auth, ok := auth.Auth.(*crypto.Ed25519Authenticator)
+		if assert.True(t, ok) {
+			assert.Equal(t, signer.PubKey().Bytes(), ed25519Auth.PubKey.Bytes())
+			assert.Equal(t, make([]byte, 64), ed25519Auth.Sig.Bytes())
+		}
+	}
+
+	feePayerTxn, err := client.BuildTransactionMultiAgent(sender.Address, payload, FeePayer(&sponsor.Address), AdditionalSigners{secondary.Address}, SequenceNumber(0), GasUnitPrice(100), MaxGasAmount(1000))
+	assert.NoError(t, err)
+	userTxn, err := client.SimulateFeePayerTransaction(feePayerTxn, sender.PubKey(), sponsor.PubKey(), secondary.PubKey())
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1234", userTxn.Hash)
+	assert.Equal(t, "true", query.Get("estimate_gas_unit_price"))
+	assert.Equal(t, "true", query.Get("estimate_max_gas_amount"))
+	assert.Equal(t, TransactionAuthenticatorFeePayer, simulated.Authenticator.Variant)
+	feePayerAuth := simulated.Authenticator.Auth.(*FeePayerTransactionAuthenticator)
+	assert.Equal(t, sponsor.Address, *feePayerAuth.FeePayer)
+	assert.Equal(t, []AccountAddress{secondary.Address}, feePayerAuth.SecondarySignerAddresses)
+	emptySignature(feePayerAuth.Sender, sender)
+	emptySignature(&feePayerAuth.SecondarySigners[0], secondary)
+	emptySignature(feePayerAuth.FeePayerAuthenticator, sponsor)
+
+	// A fee payer transaction needs the fee payer's key
+	_, err = client.SimulateFeePayerTransaction(feePayerTxn, sender.PubKey(), nil, secondary.PubKey())
+	assert.Error(t, err)
+
+	// A multi-agent transaction has no fee payer
+	multiAgentTxn, err := client.BuildTransactionMultiAgent(sender.Address, payload, AdditionalSigners{secondary.Address}, SequenceNumber(0), GasUnitPrice(100), MaxGasAmount(1000))
+	assert.NoError(t, err)
+	_, err = client.SimulateFeePayerTransaction(multiAgentTxn, sender.PubKey(), sponsor.PubKey(), secondary.PubKey())
+	assert.Error(t, err)
+	_, err = client.SimulateFeePayerTransaction(multiAgentTxn, sender.PubKey(), nil, secondary.PubKey())
+	assert.NoError(t, err)
+	assert.Equal(t, TransactionAuthenticatorMultiAgent, simulated.Authenticator.Variant)
+}
+
+func TestStreamAccountTransactions(t *testing.T) {
+	const numTxns = 250
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/0x2":
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"sequence_number":"%d","authentication_key":"0x0000000000000000000000000000000000000000000000000000000000000002"}`, numTxns)))
+		case "/accounts/0x2/transactions":
+			requests.Add(1)
+			start, _ := strconv.ParseUint(r.URL.Query().Get("start"), 10, 64)
+			limit, _ := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+			assert.LessOrEqual(t, limit, uint64(100))
+			txns := make([]string, 0)
+			for seq := start; seq < min(start+limit, numTxns); seq++ {
+				txns = append(txns, fmt.Sprintf(`{"type":"user_transaction","version":"%d","hash":"0x1234","success":true,"vm_status":"Executed successfully","sender":"0x2","sequence_number":"%d","changes":[],"events":[]}`, seq+1000, seq))
+			}
+			_, _ = w.Write([]byte("[" + strings.Join(txns, ",") + "]"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	collect := func(options ...any) []uint64 {
+		txns, err := client.StreamAccountTransactions(context.Background(), AccountTwo, options...)
+		assert.NoError(t, err)
+		sequenceNumbers := make([]uint64, 0)
+		for txn := range txns {
+			assert.NoError(t, txn.Err)
+			userTxn, err := txn.Result.UserTransaction()
+			assert.NoError(t, err)
+			sequenceNumbers = append(sequenceNumbers, userTxn.SequenceNumber)
+		}
+		return sequenceNumbers
+	}
+
+	all := collect()
+	assert.Len(t, all, numTxns)
+	assert.Equal(t, uint64(0), all[0])
+	assert.Equal(t, uint64(numTxns-1), all[numTxns-1])
+
+	assert.Equal(t, []uint64{120, 121, 122}, collect(StreamStart(120), StreamLimit(3)))
+	assert.Equal(t, []uint64{248, 249}, collect(StreamStart(248)))
+
+	descending := collect(StreamDescending(true), StreamLimit(150))
+	assert.Len(t, descending, 150)
+	assert.Equal(t, uint64(numTxns-1), descending[0])
+	assert.Equal(t, uint64(numTxns-150), descending[149])
+	assert.Equal(t, []uint64{2, 1, 0}, collect(StreamDescending(true), StreamStart(2)))
+
+	// Cancelling stops fetching more pages
+	requests.Store(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	txns, err := client.StreamAccountTransactions(ctx, AccountTwo)
+	assert.NoError(t, err)
+	<-txns
+	cancel()
+	for range txns {
+	}
+	assert.Equal(t, int32(1), requests.Load())
+
+	_, err = client.StreamAccountTransactions(context.Background(), AccountTwo, PollPeriod(time.Second))
+	assert.Error(t, err)
+}
+
+func TestSubmitTransactionIdempotent(t *testing.T) {
+	sender, err := NewEd25519Account()
+	assert.NoError(t, err)
+	payload, err := CoinTransferPayload(nil, AccountTwo, 100)
+	assert.NoError(t, err)
+	rawTxn := &RawTransaction{
+		Sender:                     sender.Address,
+		Payload:                    TransactionPayload{Payload: payload},
+		MaxGasAmount:               1000,
+		GasUnitPrice:               100,
+		ExpirationTimestampSeconds: 1714158778,
+		ChainId:                    4,
+	}
+	signedTxn, err := rawTxn.SignedTransaction(sender)
+	assert.NoError(t, err)
+	expectedHash, err := signedTxn.Hash()
+	assert.NoError(t, err)
+
+	submitted := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/transactions/by_hash/"+expectedHash:
+			if submitted.Load() == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"not found","error_code":"transaction_not_found"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"type":"user_transaction","version":"10","hash":"` + expectedHash + `","success":true,"vm_status":"Executed successfully","sender":"0x2","sequence_number":"0","changes":[],"events":[]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/transactions":
+			submitted.Add(1)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"hash":"` + expectedHash + `","sender":"0x1","sequence_number":"0","max_gas_amount":"100","gas_unit_price":"100","expiration_timestamp_secs":"1","payload":{"type":"entry_function_payload","function":"0x1::aptos_account::transfer","type_arguments":[],"arguments":[]},"signature":{"type":"ed25519_signature","public_key":"0x0000000000000000000000000000000000000000000000000000000000000000","signature":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+
+	hash, status, err := client.SubmitTransactionIdempotent(signedTxn)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, hash)
+	assert.Equal(t, TxnStatusPending, status)
+	assert.Equal(t, int32(1), submitted.Load())
+
+	// The retry finds the existing transaction, and doesn't submit again
+	hash, status, err = client.SubmitTransactionIdempotent(signedTxn)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, hash)
+	assert.Equal(t, TxnStatusCommitted, status)
+	assert.Equal(t, int32(1), submitted.Load())
+}
+
+func TestPreferBCS(t *testing.T) {
+	accountBytes, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.WriteBytes(AccountThree[:])
+		ser.U64(7)
+		// The rest of the account resource is ignored
+		ser.U64(2)
+	})
+	assert.NoError(t, err)
+	gasBytes := []byte{1, 50, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bcsRequested := r.Header.Get("Accept") == "application/x-bcs"
+		switch r.URL.Path {
+		case "/accounts/0x2":
+			if bcsRequested {
+				_, _ = w.Write(accountBytes)
+			} else {
+				_, _ = w.Write([]byte(`{"sequence_number":"7","authentication_key":"0x0000000000000000000000000000000000000000000000000000000000000003"}`))
+			}
+		case "/estimate_gas_price":
+			if bcsRequested {
+				_, _ = w.Write(gasBytes)
+			} else {
+				_, _ = w.Write([]byte(`{"deprioritized_gas_estimate":50,"gas_estimate":100}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	jsonInfo, err := client.Account(AccountTwo)
+	assert.NoError(t, err)
+	jsonGas, err := client.EstimateGasPrice()
+	assert.NoError(t, err)
+
+	// The same types are returned with either format
+	client.WithPreferBCS(true)
+	bcsInfo, err := client.Account(AccountTwo)
+	assert.NoError(t, err)
+	assert.Equal(t, jsonInfo, bcsInfo)
+	bcsGas, err := client.EstimateGasPrice()
+	assert.NoError(t, err)
+	assert.Equal(t, jsonGas, bcsGas)
+	assert.Equal(t, EstimateGasInfo{DeprioritizedGasEstimate: 50, GasEstimate: 100}, bcsGas)
+
+	_, err = client.Account(AccountThree)
+	assert.Error(t, err)
+	gasBytes = gasBytes[:5]
+	_, err = client.EstimateGasPrice()
+	assert.Error(t, err)
+}
+
+func TestUserAgent(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		_, _ = w.Write([]byte(`{"gas_estimate":150}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	_, err = client.EstimateGasPrice()
+	assert.NoError(t, err)
+
+	client.WithUserAgent("my-service/1.0")
+	_, err = client.EstimateGasPrice()
+	assert.NoError(t, err)
+	_, err = client.GetBCS(server.URL)
+	assert.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(UserAgentHeaderValue, "aptos-go-sdk/"))
+	assert.Equal(t, []string{UserAgentHeaderValue, "my-service/1.0", "my-service/1.0"}, userAgents)
+}
@@ -1,8 +1,10 @@
 package aptos
 
 import (
+	"fmt"
 	"log/slog"
 	"strconv"
+	"time"
 )
 
 // NodeInfo information retrieved about the current state of the blockchain on API requests
@@ -77,3 +79,51 @@ func (info NodeInfo) OldestBlockHeight() uint64 {
 	}
 	return value
 }
+
+// LedgerInfo is the [NodeInfo] with every field parsed, and the ledger timestamp as a time.  It's useful for
+// computing expirations relative to the ledger clock, and for displaying chain status.
+type LedgerInfo struct {
+	ChainId             uint8     // ChainId is the chain ID of the network
+	Epoch               uint64    // Epoch is the current epoch of the network
+	LedgerVersion       uint64    // LedgerVersion is the newest transaction available on the full node
+	LedgerTimestamp     time.Time // LedgerTimestamp is the time the newest block was committed, with microsecond precision
+	OldestLedgerVersion uint64    // OldestLedgerVersion is the oldest ledger version not pruned on the full node
+	BlockHeight         uint64    // BlockHeight is the newest block available on the full node
+	OldestBlockHeight   uint64    // OldestBlockHeight is the oldest block not pruned on the full node
+	NodeRole            string    // NodeRole is the role of the node in the network
+}
+
+// LedgerInfo parses every field of the node info, unlike the individual accessors it returns an error rather than
+// logging if a field is malformed
+func (info NodeInfo) LedgerInfo() (LedgerInfo, error) {
+	ledgerInfo := LedgerInfo{
+		ChainId:  info.ChainId,
+		NodeRole: info.NodeRole,
+	}
+	fields := []struct {
+		name  string
+		value string
+		out   *uint64
+	}{
+		{"epoch", info.EpochStr, &ledgerInfo.Epoch},
+		{"ledger_version", info.LedgerVersionStr, &ledgerInfo.LedgerVersion},
+		{"oldest_ledger_version", info.OldestLedgerVersionStr, &ledgerInfo.OldestLedgerVersion},
+		{"block_height", info.BlockHeightStr, &ledgerInfo.BlockHeight},
+		{"oldest_block_height", info.OldestBlockHeightStr, &ledgerInfo.OldestBlockHeight},
+	}
+	for _, field := range fields {
+		value, err := strconv.ParseUint(field.value, 10, 64)
+		if err != nil {
+			return LedgerInfo{}, fmt.Errorf("bad %s %q: %w", field.name, field.value, err)
+		}
+		*field.out = value
+	}
+
+	// The ledger timestamp is in microseconds
+	timestampMicros, err := strconv.ParseInt(info.LedgerTimestampStr, 10, 64)
+	if err != nil {
+		return LedgerInfo{}, fmt.Errorf("bad ledger_timestamp %q: %w", info.LedgerTimestampStr, err)
+	}
+	ledgerInfo.LedgerTimestamp = time.UnixMicro(timestampMicros)
+	return ledgerInfo, nil
+}
@@ -5,8 +5,11 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
+	"time"
 )
 
 type levelCounts struct {
@@ -181,3 +184,52 @@ func TestOldestBlockHeight(t *testing.T) {
 	assert.Equal(t, uint64(0), info.OldestBlockHeight())
 	assert.Equal(t, 1, lc.countingHandler.counts.get(slog.LevelError))
 }
+
+func TestLedgerInfo(t *testing.T) {
+	info := NodeInfo{
+		ChainId:                4,
+		EpochStr:               "7",
+		LedgerTimestampStr:     "1700000000123456",
+		LedgerVersionStr:       "100",
+		OldestLedgerVersionStr: "10",
+		NodeRole:               "full_node",
+		BlockHeightStr:         "50",
+		OldestBlockHeightStr:   "5",
+	}
+	ledgerInfo, err := info.LedgerInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, LedgerInfo{
+		ChainId:             4,
+		Epoch:               7,
+		LedgerVersion:       100,
+		LedgerTimestamp:     time.Unix(1_700_000_000, 123_456_000),
+		OldestLedgerVersion: 10,
+		BlockHeight:         50,
+		OldestBlockHeight:   5,
+		NodeRole:            "full_node",
+	}, ledgerInfo)
+
+	info.LedgerTimestampStr = "garbage"
+	_, err = info.LedgerInfo()
+	assert.ErrorContains(t, err, "ledger_timestamp")
+
+	info.LedgerTimestampStr = "1700000000123456"
+	info.BlockHeightStr = ""
+	_, err = info.LedgerInfo()
+	assert.ErrorContains(t, err, "block_height")
+}
+
+func TestNodeClientLedgerInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"chain_id":4,"epoch":"1","ledger_version":"10","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`))
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	ledgerInfo, err := client.LedgerInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(4), ledgerInfo.ChainId)
+	assert.Equal(t, uint64(10), ledgerInfo.LedgerVersion)
+	assert.Equal(t, time.Unix(1, 0), ledgerInfo.LedgerTimestamp)
+}
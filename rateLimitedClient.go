@@ -0,0 +1,120 @@
+package aptos
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// NewPerHostRateLimitedClient wraps an [http.Client] so that requests to each host are limited to ratePerHost requests
+// per second, with bursts of up to burst requests.  Each host has its own limit, so load is capped per node when
+// spreading requests across multiple nodes.
+//
+// Requests over the limit wait until they are allowed, or until their context is cancelled.  ratePerHost must be
+// positive, and burst must be at least 1.
+//
+//	httpClient, err := NewPerHostRateLimitedClient(http.DefaultClient, 10, 20)
+//	client, err := NewClient(MainnetConfig, httpClient)
+func NewPerHostRateLimitedClient(inner *http.Client, ratePerHost float64, burst int) (*http.Client, error) {
+	if !(ratePerHost > 0) {
+		return nil, fmt.Errorf("rate per host must be positive, got %v", ratePerHost)
+	}
+	if burst < 1 {
+		return nil, fmt.Errorf("burst must be at least 1, got %d", burst)
+	}
+	transport := inner.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client := *inner
+	client.Transport = &perHostRateLimitedTransport{
+		inner:   transport,
+		rate:    ratePerHost,
+		burst:   burst,
+		buckets: make(map[string]*tokenBucket),
+	}
+	return &client, nil
+}
+
+// perHostRateLimitedTransport is an [http.RoundTripper] that keeps a separate [tokenBucket] for each host
+type perHostRateLimitedTransport struct {
+	inner   http.RoundTripper
+	rate    float64
+	burst   int
+	lock    sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// innerTransport returns the wrapped transport
+func (rt *perHostRateLimitedTransport) innerTransport() http.RoundTripper {
+	return rt.inner
+}
+
+// setInnerTransport replaces the wrapped transport
+func (rt *perHostRateLimitedTransport) setInnerTransport(inner http.RoundTripper) {
+	rt.inner = inner
+}
+
+// RoundTrip waits for the host's rate limit, then sends the request
+//
+// Implements:
+//   - [http.RoundTripper]
+func (rt *perHostRateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.lock.Lock()
+	bucket, ok := rt.buckets[req.URL.Host]
+	if !ok {
+		bucket = &tokenBucket{
+			rate:   rt.rate,
+			burst:  float64(rt.burst),
+			tokens: float64(rt.burst),
+			last:   time.Now(),
+		}
+		rt.buckets[req.URL.Host] = bucket
+	}
+	rt.lock.Unlock()
+
+	wait := bucket.reserve()
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			// The request was never sent, so give the token back for the requests behind it
+			bucket.refund()
+			return nil, req.Context().Err()
+		}
+	}
+	return rt.inner.RoundTrip(req)
+}
+
+// tokenBucket is a simple token bucket rate limiter, safe for concurrent use
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   float64   // Tokens added per second
+	burst  float64   // Maximum number of tokens
+	tokens float64   // Current number of tokens, negative if requests are waiting
+	last   time.Time // Last time tokens were added
+}
+
+// reserve takes a token, and returns how long to wait before it can be used
+func (tb *tokenBucket) reserve() time.Duration {
+	tb.lock.Lock()
+	defer tb.lock.Unlock()
+	now := time.Now()
+	tb.tokens = min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+	tb.last = now
+	tb.tokens--
+	if tb.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-tb.tokens / tb.rate * float64(time.Second))
+}
+
+// refund returns a token taken by reserve that wasn't used
+func (tb *tokenBucket) refund() {
+	tb.lock.Lock()
+	defer tb.lock.Unlock()
+	tb.tokens = min(tb.burst, tb.tokens+1)
+}
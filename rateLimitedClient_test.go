@@ -0,0 +1,94 @@
+package aptos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerHostRateLimitedClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	otherServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer otherServer.Close()
+
+	client, err := NewPerHostRateLimitedClient(&http.Client{}, 20, 2)
+	assert.NoError(t, err)
+
+	// The burst is immediate, then requests are limited
+	start := time.Now()
+	for range 4 {
+		response, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Another host has its own limit
+	start = time.Now()
+	for range 2 {
+		response, err := client.Get(otherServer.URL)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := &tokenBucket{rate: 10, burst: 1, tokens: 1, last: time.Now()}
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.InDelta(t, float64(100*time.Millisecond), float64(bucket.reserve()), float64(5*time.Millisecond))
+	assert.InDelta(t, float64(200*time.Millisecond), float64(bucket.reserve()), float64(5*time.Millisecond))
+}
+
+func TestPerHostRateLimitedClientInvalid(t *testing.T) {
+	_, err := NewPerHostRateLimitedClient(&http.Client{}, 0, 1)
+	assert.Error(t, err)
+	_, err = NewPerHostRateLimitedClient(&http.Client{}, -1, 1)
+	assert.Error(t, err)
+	_, err = NewPerHostRateLimitedClient(&http.Client{}, 10, 0)
+	assert.Error(t, err)
+}
+
+func TestPerHostRateLimitedClientCancelRefund(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client, err := NewPerHostRateLimitedClient(&http.Client{}, 10, 1)
+	assert.NoError(t, err)
+	response, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	_ = response.Body.Close()
+
+	// Cancelled requests don't hold on to their token
+	for range 5 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+		_, err = client.Do(request)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		cancel()
+	}
+
+	// So the next request only waits for the one token in use, not the cancelled ones too
+	start := time.Now()
+	response, err = client.Get(server.URL)
+	assert.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestTokenBucketRefund(t *testing.T) {
+	bucket := &tokenBucket{rate: 10, burst: 1, tokens: 1, last: time.Now()}
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.Greater(t, bucket.reserve(), time.Duration(0))
+	bucket.refund()
+	bucket.refund()
+	// Refunds never go past the burst
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.Greater(t, bucket.reserve(), time.Duration(0))
+}
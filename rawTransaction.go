@@ -1,7 +1,12 @@
 package aptos
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/aptos-labs/aptos-go-sdk/crypto"
 	"golang.org/x/crypto/sha3"
@@ -124,6 +129,260 @@ func (txn *RawTransaction) Sign(signer crypto.Signer) (authenticator *crypto.Acc
 	return signer.Sign(message)
 }
 
+//endregion
+
+//region RawTransaction JSON
+
+// rawTransactionJSON is the human-readable JSON form of a [RawTransaction].  u64 values are strings, to match the
+// node API and not lose precision in other languages.
+type rawTransactionJSON struct {
+	Sender                  AccountAddress            `json:"sender"`
+	SequenceNumber          string                    `json:"sequence_number"`
+	MaxGasAmount            string                    `json:"max_gas_amount"`
+	GasUnitPrice            string                    `json:"gas_unit_price"`
+	ExpirationTimestampSecs string                    `json:"expiration_timestamp_secs"`
+	ChainId                 uint8                     `json:"chain_id"`
+	Payload                 rawTransactionPayloadJSON `json:"payload"`
+}
+
+// rawTransactionPayloadJSON is the JSON form of a [TransactionPayload].  Entry function arguments are kept as hex BCS,
+// as their types aren't known without the ABI.  Scripts are kept entirely as hex BCS.
+//
+// A [TransactionInnerPayload] has the executable type in Executable, and its extra config in MultisigAddress and
+// ReplayProtectionNonce.
+type rawTransactionPayloadJSON struct {
+	Type                  string          `json:"type"`
+	Executable            string          `json:"executable,omitempty"`
+	MultisigAddress       *AccountAddress `json:"multisig_address,omitempty"`
+	ReplayProtectionNonce string          `json:"replay_protection_nonce,omitempty"`
+	Function              string          `json:"function,omitempty"`
+	TypeArguments         []string        `json:"type_arguments,omitempty"`
+	Arguments             []string        `json:"arguments,omitempty"`
+	Bcs                   string          `json:"bcs,omitempty"`
+}
+
+const (
+	rawTransactionJSONEntryFunction = "entry_function_payload"
+	rawTransactionJSONScript        = "script_payload"
+	rawTransactionJSONMultisig      = "multisig_payload"
+	rawTransactionJSONInnerPayload  = "inner_payload"
+
+	rawTransactionJSONExecutableEntryFunction = "entry_function"
+	rawTransactionJSONExecutableScript        = "script"
+	rawTransactionJSONExecutableEmpty         = "empty"
+)
+
+// MarshalJSON serializes the [RawTransaction] to a human-readable JSON form, for debugging and storing pending
+// transactions.  BCS is still the canonical form for signing, and [RawTransaction.UnmarshalJSON] produces identical
+// BCS bytes.
+//
+// Implements:
+//   - [json.Marshaler]
+func (txn *RawTransaction) MarshalJSON() ([]byte, error) {
+	data := &rawTransactionJSON{
+		Sender:                  txn.Sender,
+		SequenceNumber:          strconv.FormatUint(txn.SequenceNumber, 10),
+		MaxGasAmount:            strconv.FormatUint(txn.MaxGasAmount, 10),
+		GasUnitPrice:            strconv.FormatUint(txn.GasUnitPrice, 10),
+		ExpirationTimestampSecs: strconv.FormatUint(txn.ExpirationTimestampSeconds, 10),
+		ChainId:                 txn.ChainId,
+	}
+	switch payload := txn.Payload.Payload.(type) {
+	case *EntryFunction:
+		data.Payload.Type = rawTransactionJSONEntryFunction
+		entryFunctionToJSON(payload, &data.Payload)
+	case *Multisig:
+		data.Payload.Type = rawTransactionJSONMultisig
+		data.Payload.MultisigAddress = &payload.MultisigAddress
+		if payload.Payload != nil {
+			entryFunction, ok := payload.Payload.Payload.(*EntryFunction)
+			if !ok {
+				return nil, fmt.Errorf("unsupported multisig payload type %T", payload.Payload.Payload)
+			}
+			entryFunctionToJSON(entryFunction, &data.Payload)
+		}
+	case *Script:
+		scriptBytes, err := bcs.Serialize(payload)
+		if err != nil {
+			return nil, err
+		}
+		data.Payload.Type = rawTransactionJSONScript
+		data.Payload.Bcs = BytesToHex(scriptBytes)
+	case *TransactionInnerPayload:
+		data.Payload.Type = rawTransactionJSONInnerPayload
+		data.Payload.MultisigAddress = payload.ExtraConfig.MultisigAddress
+		if payload.ExtraConfig.ReplayProtectionNonce != nil {
+			data.Payload.ReplayProtectionNonce = strconv.FormatUint(*payload.ExtraConfig.ReplayProtectionNonce, 10)
+		}
+		switch executable := payload.Executable.Payload.(type) {
+		case *EntryFunction:
+			data.Payload.Executable = rawTransactionJSONExecutableEntryFunction
+			entryFunctionToJSON(executable, &data.Payload)
+		case *Script:
+			scriptBytes, err := bcs.Serialize(executable)
+			if err != nil {
+				return nil, err
+			}
+			data.Payload.Executable = rawTransactionJSONExecutableScript
+			data.Payload.Bcs = BytesToHex(scriptBytes)
+		case nil:
+			data.Payload.Executable = rawTransactionJSONExecutableEmpty
+		default:
+			return nil, fmt.Errorf("unsupported transaction executable type %T", executable)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported transaction payload type %T", payload)
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON deserializes the [RawTransaction] from the JSON form produced by [RawTransaction.MarshalJSON]
+//
+// Implements:
+//   - [json.Unmarshaler]
+func (txn *RawTransaction) UnmarshalJSON(b []byte) error {
+	data := &rawTransactionJSON{}
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return err
+	}
+	out := RawTransaction{Sender: data.Sender, ChainId: data.ChainId}
+	out.SequenceNumber, err = StrToUint64(data.SequenceNumber)
+	if err != nil {
+		return fmt.Errorf("invalid sequence_number: %w", err)
+	}
+	out.MaxGasAmount, err = StrToUint64(data.MaxGasAmount)
+	if err != nil {
+		return fmt.Errorf("invalid max_gas_amount: %w", err)
+	}
+	out.GasUnitPrice, err = StrToUint64(data.GasUnitPrice)
+	if err != nil {
+		return fmt.Errorf("invalid gas_unit_price: %w", err)
+	}
+	out.ExpirationTimestampSeconds, err = StrToUint64(data.ExpirationTimestampSecs)
+	if err != nil {
+		return fmt.Errorf("invalid expiration_timestamp_secs: %w", err)
+	}
+
+	switch data.Payload.Type {
+	case rawTransactionJSONEntryFunction:
+		entryFunction, err := entryFunctionFromJSON(&data.Payload)
+		if err != nil {
+			return err
+		}
+		out.Payload.Payload = entryFunction
+	case rawTransactionJSONMultisig:
+		if data.Payload.MultisigAddress == nil {
+			return errors.New("missing multisig_address")
+		}
+		multisig := &Multisig{MultisigAddress: *data.Payload.MultisigAddress}
+		if data.Payload.Function != "" {
+			entryFunction, err := entryFunctionFromJSON(&data.Payload)
+			if err != nil {
+				return err
+			}
+			multisig.Payload = &MultisigTransactionPayload{
+				Variant: MultisigTransactionPayloadVariantEntryFunction,
+				Payload: entryFunction,
+			}
+		}
+		out.Payload.Payload = multisig
+	case rawTransactionJSONScript:
+		script, err := scriptFromJSON(&data.Payload)
+		if err != nil {
+			return err
+		}
+		out.Payload.Payload = script
+	case rawTransactionJSONInnerPayload:
+		inner := &TransactionInnerPayload{}
+		inner.ExtraConfig.MultisigAddress = data.Payload.MultisigAddress
+		if data.Payload.ReplayProtectionNonce != "" {
+			nonce, err := StrToUint64(data.Payload.ReplayProtectionNonce)
+			if err != nil {
+				return fmt.Errorf("invalid replay_protection_nonce: %w", err)
+			}
+			inner.ExtraConfig.ReplayProtectionNonce = &nonce
+		}
+		switch data.Payload.Executable {
+		case rawTransactionJSONExecutableEntryFunction:
+			entryFunction, err := entryFunctionFromJSON(&data.Payload)
+			if err != nil {
+				return err
+			}
+			inner.Executable = TransactionExecutable{Variant: TransactionExecutableVariantEntryFunction, Payload: entryFunction}
+		case rawTransactionJSONExecutableScript:
+			script, err := scriptFromJSON(&data.Payload)
+			if err != nil {
+				return err
+			}
+			inner.Executable = TransactionExecutable{Variant: TransactionExecutableVariantScript, Payload: script}
+		case rawTransactionJSONExecutableEmpty:
+			inner.Executable = TransactionExecutable{Variant: TransactionExecutableVariantEmpty}
+		default:
+			return fmt.Errorf("unsupported transaction executable type: %s", data.Payload.Executable)
+		}
+		out.Payload.Payload = inner
+	default:
+		return fmt.Errorf("unsupported transaction payload type: %s", data.Payload.Type)
+	}
+	*txn = out
+	return nil
+}
+
+// entryFunctionToJSON fills in the entry function fields of the JSON payload
+func entryFunctionToJSON(entryFunction *EntryFunction, data *rawTransactionPayloadJSON) {
+	data.Function = fmt.Sprintf("%s::%s::%s", entryFunction.Module.Address.String(), entryFunction.Module.Name, entryFunction.Function)
+	data.TypeArguments = typeTagStrings(entryFunction.ArgTypes)
+	data.Arguments = make([]string, len(entryFunction.Args))
+	for i, arg := range entryFunction.Args {
+		data.Arguments[i] = BytesToHex(arg)
+	}
+}
+
+// scriptFromJSON parses the hex BCS script of the JSON payload
+func scriptFromJSON(data *rawTransactionPayloadJSON) (*Script, error) {
+	scriptBytes, err := ParseHex(data.Bcs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script bcs: %w", err)
+	}
+	script := &Script{}
+	err = bcs.Deserialize(script, scriptBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script bcs: %w", err)
+	}
+	return script, nil
+}
+
+// entryFunctionFromJSON parses the entry function fields of the JSON payload
+func entryFunctionFromJSON(data *rawTransactionPayloadJSON) (*EntryFunction, error) {
+	parts := strings.Split(data.Function, "::")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid function %s, expected address::module::function", data.Function)
+	}
+	address := AccountAddress{}
+	err := address.ParseStringRelaxed(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid function address %s: %w", parts[0], err)
+	}
+	typeArgs, err := ParseTypeTags(data.TypeArguments...)
+	if err != nil {
+		return nil, err
+	}
+	args := make([][]byte, len(data.Arguments))
+	for i, arg := range data.Arguments {
+		args[i], err = ParseHex(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d: %w", i, err)
+		}
+	}
+	return &EntryFunction{
+		Module:   ModuleId{Address: address, Name: parts[1]},
+		Function: parts[2],
+		ArgTypes: typeArgs,
+		Args:     args,
+	}, nil
+}
+
 //endregion
 //endregion
 
@@ -0,0 +1,131 @@
+package aptos
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+)
+
+// stateKeyVariantAccessPath is the [StateKey] variant for resources and modules stored under an account
+const stateKeyVariantAccessPath = uint32(0)
+
+// accessPathResourceGroup is the access path variant for a resource group, e.g. 0x1::object::ObjectGroup
+const accessPathResourceGroup = uint32(2)
+
+// resourceGroupStateKey builds the BCS encoded state key of a resource group stored at address
+//
+// This is the same as `StateKey::resource_group` in the Aptos node.
+func resourceGroupStateKey(address AccountAddress, groupTag *StructTag) ([]byte, error) {
+	path, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.Uleb128(accessPathResourceGroup)
+		ser.Struct(groupTag)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.Uleb128(stateKeyVariantAccessPath)
+		ser.Struct(&address)
+		ser.WriteBytes(path)
+	})
+}
+
+// rawStateValueRequest is the body of the raw state value API
+type rawStateValueRequest struct {
+	Key string `json:"key"`
+}
+
+// AccountResourceGroupBCS fetches all members of a resource group stored at an account, e.g. 0x1::object::ObjectGroup.
+// Each member is returned as its raw Move struct BCS blob, keyed by its struct tag.
+// Optionally, a ledgerVersion can be given to get the account state at a specific ledger version
+//
+// For fetching the members as JSON, See #AccountResourceGroup
+func (rc *NodeClient) AccountResourceGroupBCS(address AccountAddress, groupType string, ledgerVersion ...uint64) (members map[string][]byte, err error) {
+	groupTag, err := ParseTypeTag(groupType)
+	if err != nil {
+		return nil, err
+	}
+	structTag, ok := groupTag.Value.(*StructTag)
+	if !ok {
+		return nil, fmt.Errorf("resource group type must be a struct, got %s", groupType)
+	}
+	stateKey, err := resourceGroupStateKey(address, structTag)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(rawStateValueRequest{Key: BytesToHex(stateKey)})
+	if err != nil {
+		return nil, err
+	}
+
+	au := rc.baseUrl.JoinPath("experimental", "state_values", "raw")
+	if len(ledgerVersion) > 0 {
+		params := url.Values{}
+		params.Set("ledger_version", strconv.FormatUint(ledgerVersion[0], 10))
+		au.RawQuery = params.Encode()
+	}
+	blob, err := postBytes(rc, au.String(), "application/json", bytes.NewReader(body), map[string]string{"Accept": "application/x-bcs"})
+	if err != nil {
+		return nil, fmt.Errorf("get resource group api err: %w", err)
+	}
+
+	// The group is stored as a BTreeMap<StructTag, vector<u8>>
+	des := bcs.NewDeserializer(blob)
+	length := des.SequenceLength()
+	// Every member takes at least one byte, so don't trust the length any further than that
+	members = make(map[string][]byte, min(int(length), des.Remaining()))
+	for i := uint32(0); i < length && des.Error() == nil; i++ {
+		member := StructTag{}
+		des.Struct(&member)
+		data := des.ReadBytes()
+		if des.Error() == nil {
+			members[member.String()] = data
+		}
+	}
+	if des.Error() != nil {
+		return nil, fmt.Errorf("failed to deserialize resource group %s: %w", groupType, des.Error())
+	}
+	return members, nil
+}
+
+// AccountResourceGroup fetches all members of a resource group stored at an account, e.g. 0x1::object::ObjectGroup,
+// into JSON-like maps keyed by the member's type.
+// Optionally, a ledgerVersion can be given to get the account state at a specific ledger version
+//
+// The group membership is read from the resource group itself, then each member is fetched and decoded by the node,
+// all at the same ledger version.
+//
+// For fetching raw Move structs as BCS, See #AccountResourceGroupBCS
+func (rc *NodeClient) AccountResourceGroup(address AccountAddress, groupType string, ledgerVersion ...uint64) (members map[string]map[string]any, err error) {
+	// Pin the version, so the membership and the member data agree
+	if len(ledgerVersion) == 0 {
+		info, err := rc.Info()
+		if err != nil {
+			return nil, err
+		}
+		ledgerVersion = []uint64{info.LedgerVersion()}
+	}
+
+	memberBytes, err := rc.AccountResourceGroupBCS(address, groupType, ledgerVersion...)
+	if err != nil {
+		return nil, err
+	}
+
+	members = make(map[string]map[string]any, len(memberBytes))
+	for memberType := range memberBytes {
+		resource, err := rc.AccountResource(address, memberType, ledgerVersion...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch resource group %s member %s: %w", groupType, memberType, err)
+		}
+		data, ok := resource["data"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("resource group %s member %s has no data", groupType, memberType)
+		}
+		members[memberType] = data
+	}
+	return members, nil
+}
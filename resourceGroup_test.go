@@ -0,0 +1,80 @@
+package aptos
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountResourceGroup(t *testing.T) {
+	objectAddress := AccountAddress{}
+	err := objectAddress.ParseStringRelaxed("0x1234")
+	assert.NoError(t, err)
+	groupTag := MustParseTypeTag("0x1::object::ObjectGroup").Value.(*StructTag)
+	expectedKey, err := resourceGroupStateKey(objectAddress, groupTag)
+	assert.NoError(t, err)
+
+	// Group with ObjectCore and a token member
+	group, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.Uleb128(2)
+		ser.Struct(MustParseTypeTag("0x1::object::ObjectCore").Value.(*StructTag))
+		ser.WriteBytes([]byte{1, 2, 3})
+		ser.Struct(MustParseTypeTag("0x4::token::Token").Value.(*StructTag))
+		ser.WriteBytes([]byte{4, 5})
+	})
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(`{"chain_id":4,"epoch":"1","ledger_version":"10","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`))
+		case "/experimental/state_values/raw":
+			assert.Equal(t, "10", r.URL.Query().Get("ledger_version"))
+			assert.Equal(t, "application/x-bcs", r.Header.Get("Accept"))
+			body, _ := io.ReadAll(r.Body)
+			request := rawStateValueRequest{}
+			assert.NoError(t, json.Unmarshal(body, &request))
+			assert.Equal(t, BytesToHex(expectedKey), request.Key)
+			_, _ = w.Write(group)
+		case "/accounts/" + objectAddress.String() + "/resource/0x1::object::ObjectCore":
+			assert.Equal(t, "10", r.URL.Query().Get("ledger_version"))
+			_, _ = w.Write([]byte(`{"type":"0x1::object::ObjectCore","data":{"owner":"0x1"}}`))
+		case "/accounts/" + objectAddress.String() + "/resource/0x4::token::Token":
+			assert.Equal(t, "10", r.URL.Query().Get("ledger_version"))
+			_, _ = w.Write([]byte(`{"type":"0x4::token::Token","data":{"name":"token"}}`))
+		case "/accounts/" + objectAddress.String() + "/resources":
+			assert.Fail(t, "only the group's members should be fetched")
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+
+	memberBytes, err := client.AccountResourceGroupBCS(objectAddress, "0x1::object::ObjectGroup", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"0x1::object::ObjectCore": {1, 2, 3},
+		"0x4::token::Token":       {4, 5},
+	}, memberBytes)
+
+	// Each member is fetched individually
+	members, err := client.AccountResourceGroup(objectAddress, "0x1::object::ObjectGroup")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]any{
+		"0x1::object::ObjectCore": {"owner": "0x1"},
+		"0x4::token::Token":       {"name": "token"},
+	}, members)
+
+	// The group must be a struct
+	_, err = client.AccountResourceGroup(objectAddress, "u64")
+	assert.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package aptos
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+)
+
+// resourceTypes maps the canonical type string of a resource to a factory for its BCS struct, see [RegisterResourceType]
+var resourceTypes = map[string]func() bcs.Unmarshaler{}
+var resourceTypesLock sync.RWMutex
+
+// canonicalResourceType parses the type string, so equivalent forms e.g. 0x1::coin::CoinStore and
+// 0x0000000000000000000000000000000000000000000000000000000000000001::coin::CoinStore are the same key
+func canonicalResourceType(typeTag string) (string, error) {
+	parsed, err := ParseTypeTag(typeTag)
+	if err != nil {
+		return "", fmt.Errorf("invalid resource type %s: %w", typeTag, err)
+	}
+	return parsed.String(), nil
+}
+
+// RegisterResourceType registers a factory for the Go struct of a Move resource, so [DecodeResourceBCS] can decode it.
+// The type must include any type parameters e.g. 0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>.  Registering a
+// type again replaces the factory.
+//
+// This is typically called from an init function:
+//
+//	func init() {
+//		_ = aptos.RegisterResourceType("0x1::account::Account", func() bcs.Unmarshaler { return &AccountResource{} })
+//	}
+func RegisterResourceType(typeTag string, factory func() bcs.Unmarshaler) error {
+	key, err := canonicalResourceType(typeTag)
+	if err != nil {
+		return err
+	}
+	if factory == nil {
+		return fmt.Errorf("factory for resource type %s must not be nil", key)
+	}
+	resourceTypesLock.Lock()
+	defer resourceTypesLock.Unlock()
+	resourceTypes[key] = factory
+	return nil
+}
+
+// DecodeResourceBCS decodes the BCS bytes of a resource, with the struct registered by [RegisterResourceType] for its
+// type.  The result is the pointer returned by the factory e.g. *AccountResource.
+//
+// Returns an error if the type isn't registered, or the bytes don't deserialize into the struct.
+func DecodeResourceBCS(typeTag string, data []byte) (any, error) {
+	key, err := canonicalResourceType(typeTag)
+	if err != nil {
+		return nil, err
+	}
+	resourceTypesLock.RLock()
+	factory, ok := resourceTypes[key]
+	resourceTypesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("resource type %s is not registered", key)
+	}
+
+	value := factory()
+	if err = bcs.Deserialize(value, data); err != nil {
+		return nil, fmt.Errorf("failed to decode resource %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// Decode decodes the resource's BCS data with the struct registered for its type, see [DecodeResourceBCS]
+//
+//	resources, _ := client.AccountResourcesBCS(address)
+//	for _, resource := range resources {
+//		value, err := resource.Decode()
+//	}
+func (aar *AccountResourceRecord) Decode() (any, error) {
+	return DecodeResourceBCS(aar.Tag.String(), aar.Data)
+}
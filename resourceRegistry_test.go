@@ -0,0 +1,57 @@
+package aptos
+
+import (
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCoinStore is a partial CoinStore, only the coin value
+type testCoinStore struct {
+	Value uint64
+}
+
+func (store *testCoinStore) UnmarshalBCS(des *bcs.Deserializer) {
+	store.Value = des.U64()
+}
+
+// unregisterResourceType removes a type registered by a test, so the global registry doesn't leak into other tests
+func unregisterResourceType(t *testing.T, typeTag string) {
+	key, err := canonicalResourceType(typeTag)
+	assert.NoError(t, err)
+	resourceTypesLock.Lock()
+	defer resourceTypesLock.Unlock()
+	delete(resourceTypes, key)
+}
+
+func TestDecodeResourceBCS(t *testing.T) {
+	const typeTag = "0x0000000000000000000000000000000000000000000000000000000000000001::coin::CoinStore<0x1::aptos_coin::AptosCoin>"
+	data, err := bcs.SerializeU64(500)
+	assert.NoError(t, err)
+
+	_, err = DecodeResourceBCS(typeTag, data)
+	assert.Error(t, err)
+
+	assert.NoError(t, RegisterResourceType(typeTag, func() bcs.Unmarshaler { return &testCoinStore{} }))
+	t.Cleanup(func() { unregisterResourceType(t, typeTag) })
+	assert.Error(t, RegisterResourceType("not a type", func() bcs.Unmarshaler { return &testCoinStore{} }))
+	assert.Error(t, RegisterResourceType(typeTag, nil))
+
+	// Equivalent type strings decode with the same struct
+	value, err := DecodeResourceBCS("0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", data)
+	assert.NoError(t, err)
+	assert.Equal(t, &testCoinStore{Value: 500}, value)
+
+	record := AccountResourceRecord{Tag: *AptosCoinStoreTypeTag().Value.(*StructTag), Data: data}
+	value, err = record.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, &testCoinStore{Value: 500}, value)
+
+	// Other type parameters are different types
+	_, err = DecodeResourceBCS("0x1::coin::CoinStore<0x3::coin::Coin>", data)
+	assert.Error(t, err)
+
+	_, err = DecodeResourceBCS(typeTag, append(data, 1))
+	assert.Error(t, err)
+}
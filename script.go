@@ -44,19 +44,20 @@ func (s *Script) UnmarshalBCS(des *bcs.Deserializer) {
 
 // ScriptArgumentVariant the type of the script argument.  If there isn't a value here, it is not supported.
 //
-// Note that the only vector supported is vector<u8>
+// Note that the only vector supported directly is vector<u8>, other types must use [ScriptArgumentSerialized]
 type ScriptArgumentVariant uint32
 
 const (
-	ScriptArgumentU8       ScriptArgumentVariant = 0 // u8 type argument
-	ScriptArgumentU64      ScriptArgumentVariant = 1 // u64 type argument
-	ScriptArgumentU128     ScriptArgumentVariant = 2 // u128 type argument
-	ScriptArgumentAddress  ScriptArgumentVariant = 3 // address type argument
-	ScriptArgumentU8Vector ScriptArgumentVariant = 4 // vector<u8> type argument
-	ScriptArgumentBool     ScriptArgumentVariant = 5 // bool type argument
-	ScriptArgumentU16      ScriptArgumentVariant = 6 // u16 type argument
-	ScriptArgumentU32      ScriptArgumentVariant = 7 //	u32 type argument
-	ScriptArgumentU256     ScriptArgumentVariant = 8 //	u256 type argument
+	ScriptArgumentU8         ScriptArgumentVariant = 0 // u8 type argument
+	ScriptArgumentU64        ScriptArgumentVariant = 1 // u64 type argument
+	ScriptArgumentU128       ScriptArgumentVariant = 2 // u128 type argument
+	ScriptArgumentAddress    ScriptArgumentVariant = 3 // address type argument
+	ScriptArgumentU8Vector   ScriptArgumentVariant = 4 // vector<u8> type argument
+	ScriptArgumentBool       ScriptArgumentVariant = 5 // bool type argument
+	ScriptArgumentU16        ScriptArgumentVariant = 6 // u16 type argument
+	ScriptArgumentU32        ScriptArgumentVariant = 7 //	u32 type argument
+	ScriptArgumentU256       ScriptArgumentVariant = 8 //	u256 type argument
+	ScriptArgumentSerialized ScriptArgumentVariant = 9 // BCS serialized argument of any type, e.g. vector<u64> or String
 )
 
 // ScriptArgument a Move script argument, which encodes its type with it
@@ -125,6 +126,12 @@ func (sa *ScriptArgument) MarshalBCS(ser *bcs.Serializer) {
 			ser.SetError(fmt.Errorf("invalid input type (%T) for ScriptArgumentBool, must be bool", sa.Value))
 		}
 		ser.Bool(value)
+	case ScriptArgumentSerialized:
+		bytes, ok := (sa.Value).([]byte)
+		if !ok {
+			ser.SetError(fmt.Errorf("invalid input type (%T) for ScriptArgumentSerialized, must be []byte", sa.Value))
+		}
+		ser.WriteBytes(bytes)
 	}
 }
 
@@ -151,7 +158,124 @@ func (sa *ScriptArgument) UnmarshalBCS(des *bcs.Deserializer) {
 		sa.Value = des.ReadBytes()
 	case ScriptArgumentBool:
 		sa.Value = des.Bool()
+	case ScriptArgumentSerialized:
+		sa.Value = des.ReadBytes()
+	}
+}
+
+//endregion
+
+//region ScriptArgument constructors
+
+// ScriptArgU8 builds a u8 [ScriptArgument]
+func ScriptArgU8(v uint8) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentU8, Value: v}
+}
+
+// ScriptArgU16 builds a u16 [ScriptArgument]
+func ScriptArgU16(v uint16) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentU16, Value: v}
+}
+
+// ScriptArgU32 builds a u32 [ScriptArgument]
+func ScriptArgU32(v uint32) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentU32, Value: v}
+}
+
+// ScriptArgU64 builds a u64 [ScriptArgument]
+func ScriptArgU64(v uint64) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentU64, Value: v}
+}
+
+// ScriptArgU128 builds a u128 [ScriptArgument]
+func ScriptArgU128(v big.Int) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentU128, Value: v}
+}
+
+// ScriptArgU256 builds a u256 [ScriptArgument]
+func ScriptArgU256(v big.Int) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentU256, Value: v}
+}
+
+// ScriptArgBool builds a bool [ScriptArgument]
+func ScriptArgBool(v bool) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentBool, Value: v}
+}
+
+// ScriptArgAddress builds an address [ScriptArgument]
+func ScriptArgAddress(a AccountAddress) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentAddress, Value: a}
+}
+
+// ScriptArgVectorU8 builds a vector<u8> [ScriptArgument]
+func ScriptArgVectorU8(vs []byte) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentU8Vector, Value: vs}
+}
+
+// ScriptArgSerialized builds a [ScriptArgument] from an already BCS serialized value, for types without their own
+// variant
+func ScriptArgSerialized(bytes []byte) ScriptArgument {
+	return ScriptArgument{Variant: ScriptArgumentSerialized, Value: bytes}
+}
+
+// ScriptArgString builds a 0x1::string::String [ScriptArgument]
+func ScriptArgString(v string) (ScriptArgument, error) {
+	bytes, err := bcs.SerializeBytes([]byte(v))
+	if err != nil {
+		return ScriptArgument{}, err
+	}
+	return ScriptArgSerialized(bytes), nil
+}
+
+// ScriptArgVectorU16 builds a vector<u16> [ScriptArgument]
+func ScriptArgVectorU16(vs []uint16) (ScriptArgument, error) {
+	return scriptArgVector(vs, (*bcs.Serializer).U16)
+}
+
+// ScriptArgVectorU32 builds a vector<u32> [ScriptArgument]
+func ScriptArgVectorU32(vs []uint32) (ScriptArgument, error) {
+	return scriptArgVector(vs, (*bcs.Serializer).U32)
+}
+
+// ScriptArgVectorU64 builds a vector<u64> [ScriptArgument]
+func ScriptArgVectorU64(vs []uint64) (ScriptArgument, error) {
+	return scriptArgVector(vs, (*bcs.Serializer).U64)
+}
+
+// ScriptArgVectorU128 builds a vector<u128> [ScriptArgument]
+func ScriptArgVectorU128(vs []big.Int) (ScriptArgument, error) {
+	return scriptArgVector(vs, (*bcs.Serializer).U128)
+}
+
+// ScriptArgVectorU256 builds a vector<u256> [ScriptArgument]
+func ScriptArgVectorU256(vs []big.Int) (ScriptArgument, error) {
+	return scriptArgVector(vs, (*bcs.Serializer).U256)
+}
+
+// ScriptArgVectorBool builds a vector<bool> [ScriptArgument]
+func ScriptArgVectorBool(vs []bool) (ScriptArgument, error) {
+	return scriptArgVector(vs, (*bcs.Serializer).Bool)
+}
+
+// ScriptArgVectorAddress builds a vector<address> [ScriptArgument]
+func ScriptArgVectorAddress(as []AccountAddress) (ScriptArgument, error) {
+	return scriptArgVector(as, func(ser *bcs.Serializer, a AccountAddress) {
+		ser.Struct(&a)
+	})
+}
+
+// ScriptArgVectorString builds a vector<0x1::string::String> [ScriptArgument]
+func ScriptArgVectorString(vs []string) (ScriptArgument, error) {
+	return scriptArgVector(vs, (*bcs.Serializer).WriteString)
+}
+
+// scriptArgVector serializes a vector into a [ScriptArgumentSerialized] argument
+func scriptArgVector[T any](vs []T, serialize func(ser *bcs.Serializer, item T)) (ScriptArgument, error) {
+	bytes, err := bcs.SerializeSequenceOf(vs, serialize)
+	if err != nil {
+		return ScriptArgument{}, err
 	}
+	return ScriptArgSerialized(bytes), nil
 }
 
 //endregion
@@ -0,0 +1,124 @@
+package aptos
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/aptos-labs/aptos-go-sdk/api"
+)
+
+// Move VM status codes for sequence number validation failures
+const (
+	vmStatusSequenceNumberTooOld = 3
+	vmStatusSequenceNumberTooNew = 4
+)
+
+// SequenceManager hands out sequence numbers for an account locally, so many transactions can be built and submitted
+// without waiting on the node in between.  It is safe for concurrent use.
+//
+// If a submission fails due to a bad sequence number, e.g. a previous transaction was dropped, pass the error to
+// [SequenceManager.HandleError] to resynchronize with the node.
+//
+//	sequenceManager := client.SequenceManagerFor(sender.AccountAddress())
+//	sequenceNumber, err := sequenceManager.Next()
+//	rawTxn, err := client.BuildTransaction(sender.AccountAddress(), payload, SequenceNumber(sequenceNumber))
+//	...
+//	_, err = client.SubmitTransaction(signedTxn)
+//	if resynced, _ := sequenceManager.HandleError(err); resynced {
+//		// Rebuild and resubmit the transaction
+//	}
+type SequenceManager struct {
+	nodeClient  *NodeClient
+	address     AccountAddress
+	lock        sync.Mutex
+	next        uint64 // Next sequence number to hand out
+	initialized bool   // Whether next has been fetched from the node
+}
+
+// SequenceManagerFor returns the [SequenceManager] for the account.  The same manager is returned for every call with
+// the same address, so that concurrent users share sequence numbers.
+func (rc *NodeClient) SequenceManagerFor(address AccountAddress) *SequenceManager {
+	rc.sequenceManagersLock.Lock()
+	defer rc.sequenceManagersLock.Unlock()
+	if rc.sequenceManagers == nil {
+		rc.sequenceManagers = make(map[AccountAddress]*SequenceManager)
+	}
+	sequenceManager, ok := rc.sequenceManagers[address]
+	if !ok {
+		sequenceManager = &SequenceManager{nodeClient: rc, address: address}
+		rc.sequenceManagers[address] = sequenceManager
+	}
+	return sequenceManager
+}
+
+// Address returns the account the sequence numbers are for
+func (sm *SequenceManager) Address() AccountAddress {
+	return sm.address
+}
+
+// Next returns the next sequence number to use, fetching the current sequence number from the node on first use
+func (sm *SequenceManager) Next() (uint64, error) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	if !sm.initialized {
+		err := sm.resyncLocked()
+		if err != nil {
+			return 0, err
+		}
+	}
+	sequenceNumber := sm.next
+	sm.next++
+	return sequenceNumber, nil
+}
+
+// Resync fetches the current sequence number from the node, discarding any sequence numbers handed out locally
+func (sm *SequenceManager) Resync() error {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	return sm.resyncLocked()
+}
+
+// HandleError resynchronizes with the node if err is a sequence number too old or too new error from submission.
+// Returns true if it resynchronized, in which case the transaction should be rebuilt with a new sequence number.
+func (sm *SequenceManager) HandleError(err error) (bool, error) {
+	if !isSequenceNumberError(err) {
+		return false, nil
+	}
+	resyncErr := sm.Resync()
+	if resyncErr != nil {
+		return false, resyncErr
+	}
+	return true, nil
+}
+
+// resyncLocked fetches the sequence number from the node, the lock must be held
+func (sm *SequenceManager) resyncLocked() error {
+	sequenceNumber, err := sm.nodeClient.accountSequenceNumberOrZero(sm.address)
+	if err != nil {
+		return err
+	}
+	sm.next = sequenceNumber
+	sm.initialized = true
+	return nil
+}
+
+// isSequenceNumberError checks if the error is a node API error for a sequence number that is too old or too new
+func isSequenceNumberError(err error) bool {
+	var httpErr *HttpError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	apiErr := &api.Error{}
+	if json.Unmarshal(httpErr.Body, apiErr) != nil {
+		return false
+	}
+	switch apiErr.ErrorCode {
+	case "sequence_number_too_old", "sequence_number_too_new":
+		return true
+	case "vm_error":
+		return apiErr.VmErrorCode == vmStatusSequenceNumberTooOld || apiErr.VmErrorCode == vmStatusSequenceNumberTooNew
+	default:
+		return false
+	}
+}
@@ -0,0 +1,69 @@
+package aptos
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequenceManager(t *testing.T) {
+	onChainSequenceNumber := atomic.Uint64{}
+	onChainSequenceNumber.Store(5)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/0x2":
+			_, _ = fmt.Fprintf(w, `{"sequence_number":"%d","authentication_key":"0x0000000000000000000000000000000000000000000000000000000000000002"}`, onChainSequenceNumber.Load())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found","error_code":"account_not_found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	sequenceManager := client.SequenceManagerFor(AccountTwo)
+	assert.Same(t, sequenceManager, client.SequenceManagerFor(AccountTwo))
+
+	// Concurrent callers get unique, consecutive sequence numbers
+	const numCallers = 20
+	seen := make([]atomic.Bool, numCallers)
+	wg := sync.WaitGroup{}
+	for range numCallers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sequenceNumber, err := sequenceManager.Next()
+			assert.NoError(t, err)
+			assert.False(t, seen[sequenceNumber-5].Swap(true))
+		}()
+	}
+	wg.Wait()
+
+	// Non sequence number errors are ignored
+	resynced, err := sequenceManager.HandleError(&HttpError{StatusCode: 400, Body: []byte(`{"message":"bad","error_code":"invalid_input"}`)})
+	assert.NoError(t, err)
+	assert.False(t, resynced)
+	next, err := sequenceManager.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5+numCallers), next)
+
+	// A dropped transaction means the sequence number is too new, so it resyncs
+	onChainSequenceNumber.Store(9)
+	resynced, err = sequenceManager.HandleError(&HttpError{StatusCode: 400, Body: []byte(`{"message":"SEQUENCE_NUMBER_TOO_NEW","error_code":"vm_error","vm_error_code":4}`)})
+	assert.NoError(t, err)
+	assert.True(t, resynced)
+	next, err = sequenceManager.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9), next)
+
+	// Accounts that don't exist yet start at 0
+	next, err = client.SequenceManagerFor(AccountThree).Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), next)
+}
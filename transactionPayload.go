@@ -1,8 +1,11 @@
 package aptos
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"strings"
+
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 )
 
@@ -15,6 +18,7 @@ const (
 	TransactionPayloadVariantModuleBundle  TransactionPayloadVariant = 1 // Deprecated
 	TransactionPayloadVariantEntryFunction TransactionPayloadVariant = 2
 	TransactionPayloadVariantMultisig      TransactionPayloadVariant = 3
+	TransactionPayloadVariantPayload       TransactionPayloadVariant = 4 // Versioned payload, used for orderless transactions
 )
 
 type TransactionPayloadImpl interface {
@@ -50,6 +54,8 @@ func (txn *TransactionPayload) UnmarshalBCS(des *bcs.Deserializer) {
 		txn.Payload = &EntryFunction{}
 	case TransactionPayloadVariantMultisig:
 		txn.Payload = &Multisig{}
+	case TransactionPayloadVariantPayload:
+		txn.Payload = &TransactionInnerPayload{}
 	default:
 		des.SetError(fmt.Errorf("bad txn payload kind, %d", payloadType))
 		return
@@ -97,6 +103,58 @@ func (sf *EntryFunction) PayloadType() TransactionPayloadVariant {
 
 //endregion
 
+// String renders the entry function call with its type arguments, and its arguments as hex BCS bytes e.g.
+// 0x1::aptos_account::transfer_coins<0x1::aptos_coin::AptosCoin>(0x01..., 0x6400000000000000)
+func (sf *EntryFunction) String() string {
+	out := strings.Builder{}
+	out.WriteString(sf.Module.String())
+	out.WriteString("::")
+	out.WriteString(sf.Function)
+	if len(sf.ArgTypes) != 0 {
+		out.WriteRune('<')
+		for i, typeArg := range sf.ArgTypes {
+			if i != 0 {
+				out.WriteRune(',')
+			}
+			out.WriteString(typeArg.String())
+		}
+		out.WriteRune('>')
+	}
+	out.WriteRune('(')
+	for i, arg := range sf.Args {
+		if i != 0 {
+			out.WriteString(", ")
+		}
+		out.WriteString(BytesToHex(arg))
+	}
+	out.WriteRune(')')
+	return out.String()
+}
+
+// Equals returns true if both entry functions call the same function, with the same type arguments and argument bytes
+func (sf *EntryFunction) Equals(other *EntryFunction) bool {
+	if sf == nil || other == nil {
+		return sf == nil && other == nil
+	}
+	if sf.Module != other.Module || sf.Function != other.Function {
+		return false
+	}
+	if len(sf.ArgTypes) != len(other.ArgTypes) || len(sf.Args) != len(other.Args) {
+		return false
+	}
+	for i := range sf.ArgTypes {
+		if !sf.ArgTypes[i].Equals(other.ArgTypes[i]) {
+			return false
+		}
+	}
+	for i := range sf.Args {
+		if !bytes.Equal(sf.Args[i], other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 //region EntryFunction bcs.Struct
 
 func (sf *EntryFunction) MarshalBCS(ser *bcs.Serializer) {
@@ -112,11 +170,9 @@ func (sf *EntryFunction) UnmarshalBCS(des *bcs.Deserializer) {
 	sf.Module.UnmarshalBCS(des)
 	sf.Function = des.ReadString()
 	sf.ArgTypes = bcs.DeserializeSequence[TypeTag](des)
-	alen := des.Uleb128()
-	sf.Args = make([][]byte, alen)
-	for i := range alen {
-		sf.Args[i] = des.ReadBytes()
-	}
+	sf.Args = bcs.DeserializeSequenceWithFunction(des, func(des *bcs.Deserializer, out *[]byte) {
+		*out = des.ReadBytes()
+	})
 }
 
 //endregion
@@ -200,3 +256,185 @@ func (sf *MultisigTransactionPayload) UnmarshalBCS(des *bcs.Deserializer) {
 
 //endregion
 //endregion
+
+//region TransactionInnerPayload
+
+// OrderlessSequenceNumber is the placeholder sequence number of an orderless transaction, it is ignored by the node
+// as the replay protection nonce is used instead
+const OrderlessSequenceNumber = uint64(0xdeadbeef)
+
+// TransactionInnerPayload is the versioned payload, which splits what to execute from extra configuration such as the
+// replay protection nonce of an orderless transaction.  Use [OrderlessPayload] to build one from an existing payload.
+//
+// Only the V1 variant exists, so it is always serialized as V1.
+type TransactionInnerPayload struct {
+	Executable  TransactionExecutable
+	ExtraConfig TransactionExtraConfig
+}
+
+// OrderlessPayload wraps an [EntryFunction], [Script], or [Multisig] payload with a replay protection nonce, for an
+// orderless transaction.  Orderless transactions don't use the sender's sequence number, so many can be submitted
+// concurrently, but the nonce must be unique for the sender until the transaction expires.
+//
+// Usually this is done with the [Orderless] option to [NodeClient.BuildTransaction] instead.
+func OrderlessPayload(payload TransactionPayload, nonce uint64) (TransactionPayload, error) {
+	inner := &TransactionInnerPayload{
+		ExtraConfig: TransactionExtraConfig{ReplayProtectionNonce: &nonce},
+	}
+	switch p := payload.Payload.(type) {
+	case *EntryFunction:
+		inner.Executable = TransactionExecutable{Variant: TransactionExecutableVariantEntryFunction, Payload: p}
+	case *Script:
+		inner.Executable = TransactionExecutable{Variant: TransactionExecutableVariantScript, Payload: p}
+	case *Multisig:
+		multisigAddress := p.MultisigAddress
+		inner.ExtraConfig.MultisigAddress = &multisigAddress
+		if p.Payload == nil {
+			// The transaction was previously stored on-chain
+			inner.Executable = TransactionExecutable{Variant: TransactionExecutableVariantEmpty}
+		} else {
+			entryFunction, ok := p.Payload.Payload.(*EntryFunction)
+			if !ok {
+				return TransactionPayload{}, fmt.Errorf("unsupported multisig payload type %T", p.Payload.Payload)
+			}
+			inner.Executable = TransactionExecutable{Variant: TransactionExecutableVariantEntryFunction, Payload: entryFunction}
+		}
+	case *TransactionInnerPayload:
+		copied := *p
+		copied.ExtraConfig.ReplayProtectionNonce = &nonce
+		inner = &copied
+	default:
+		return TransactionPayload{}, fmt.Errorf("unsupported payload type %T for an orderless transaction", payload.Payload)
+	}
+	return TransactionPayload{Payload: inner}, nil
+}
+
+//region TransactionInnerPayload TransactionPayloadImpl
+
+func (sf *TransactionInnerPayload) PayloadType() TransactionPayloadVariant {
+	return TransactionPayloadVariantPayload
+}
+
+//endregion
+
+//region TransactionInnerPayload bcs.Struct
+
+func (sf *TransactionInnerPayload) MarshalBCS(ser *bcs.Serializer) {
+	ser.Uleb128(0) // V1
+	ser.Struct(&sf.Executable)
+	ser.Struct(&sf.ExtraConfig)
+}
+func (sf *TransactionInnerPayload) UnmarshalBCS(des *bcs.Deserializer) {
+	version := des.Uleb128()
+	if des.Error() != nil {
+		return
+	}
+	if version != 0 {
+		des.SetError(fmt.Errorf("bad version %d for TransactionInnerPayload", version))
+		return
+	}
+	des.Struct(&sf.Executable)
+	des.Struct(&sf.ExtraConfig)
+}
+
+//endregion
+//endregion
+
+//region TransactionExecutable
+
+type TransactionExecutableVariant uint32
+
+const (
+	TransactionExecutableVariantScript        TransactionExecutableVariant = 0
+	TransactionExecutableVariantEntryFunction TransactionExecutableVariant = 1
+	TransactionExecutableVariantEmpty         TransactionExecutableVariant = 2
+)
+
+// TransactionExecutable is what a [TransactionInnerPayload] executes, Payload is nil for the Empty variant
+type TransactionExecutable struct {
+	Variant TransactionExecutableVariant
+	Payload TransactionPayloadImpl // *Script, *EntryFunction, or nil
+}
+
+//region TransactionExecutable bcs.Struct
+
+func (sf *TransactionExecutable) MarshalBCS(ser *bcs.Serializer) {
+	ser.Uleb128(uint32(sf.Variant))
+	switch sf.Variant {
+	case TransactionExecutableVariantScript, TransactionExecutableVariantEntryFunction:
+		if sf.Payload == nil {
+			ser.SetError(fmt.Errorf("nil payload for TransactionExecutable variant %d", sf.Variant))
+			return
+		}
+		ser.Struct(sf.Payload)
+	case TransactionExecutableVariantEmpty:
+	default:
+		ser.SetError(fmt.Errorf("bad variant %d for TransactionExecutable", sf.Variant))
+	}
+}
+func (sf *TransactionExecutable) UnmarshalBCS(des *bcs.Deserializer) {
+	sf.Variant = TransactionExecutableVariant(des.Uleb128())
+	switch sf.Variant {
+	case TransactionExecutableVariantScript:
+		sf.Payload = &Script{}
+	case TransactionExecutableVariantEntryFunction:
+		sf.Payload = &EntryFunction{}
+	case TransactionExecutableVariantEmpty:
+		sf.Payload = nil
+		return
+	default:
+		des.SetError(fmt.Errorf("bad variant %d for TransactionExecutable", sf.Variant))
+		return
+	}
+	des.Struct(sf.Payload)
+}
+
+//endregion
+//endregion
+
+//region TransactionExtraConfig
+
+// TransactionExtraConfig is the configuration of a [TransactionInnerPayload] besides what it executes
+//
+// Only the V1 variant exists, so it is always serialized as V1.
+type TransactionExtraConfig struct {
+	MultisigAddress       *AccountAddress // Optional, for on-chain multisig transactions
+	ReplayProtectionNonce *uint64         // Optional, for orderless transactions
+}
+
+//region TransactionExtraConfig bcs.Struct
+
+func (sf *TransactionExtraConfig) MarshalBCS(ser *bcs.Serializer) {
+	ser.Uleb128(0) // V1
+	if sf.MultisigAddress == nil {
+		ser.Bool(false)
+	} else {
+		ser.Bool(true)
+		ser.Struct(sf.MultisigAddress)
+	}
+	if sf.ReplayProtectionNonce == nil {
+		ser.Bool(false)
+	} else {
+		ser.Bool(true)
+		ser.U64(*sf.ReplayProtectionNonce)
+	}
+}
+func (sf *TransactionExtraConfig) UnmarshalBCS(des *bcs.Deserializer) {
+	version := des.Uleb128()
+	if des.Error() != nil {
+		return
+	}
+	if version != 0 {
+		des.SetError(fmt.Errorf("bad version %d for TransactionExtraConfig", version))
+		return
+	}
+	sf.MultisigAddress = bcs.DeserializeOption(des, func(des *bcs.Deserializer, out *AccountAddress) {
+		des.Struct(out)
+	})
+	sf.ReplayProtectionNonce = bcs.DeserializeOption(des, func(des *bcs.Deserializer, out *uint64) {
+		*out = des.U64()
+	})
+}
+
+//endregion
+//endregion
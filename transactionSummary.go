@@ -0,0 +1,360 @@
+package aptos
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+)
+
+// TransactionSummary is a human-readable summary of a [RawTransaction], so a signer can review what they are authorizing
+// before signing.  This is most useful in MultiAgent and FeePayer flows, where the transaction is built by another party.
+//
+// See [NodeClient.InspectRawTransaction]
+type TransactionSummary struct {
+	Sender                     AccountAddress               // Sender is the account sending the transaction
+	SequenceNumber             uint64                       // SequenceNumber is the sender's sequence number
+	MaxGasAmount               uint64                       // MaxGasAmount is the maximum gas units the transaction can use
+	GasUnitPrice               uint64                       // GasUnitPrice is the price per gas unit in octas
+	ExpirationTimestampSeconds uint64                       // ExpirationTimestampSeconds is seconds since Unix epoch
+	ChainId                    uint8                        // ChainId is the chain the transaction is valid on
+	PayloadType                TransactionPayloadVariant    // PayloadType is the type of the payload e.g. entry function or script
+	MultisigAddress            *AccountAddress              // MultisigAddress is the multisig account, only for multisig payloads
+	ReplayProtectionNonce      *uint64                      // ReplayProtectionNonce is the nonce of an orderless transaction, which replaces the sequence number
+	Function                   string                       // Function is the entry function called e.g. 0x1::aptos_account::transfer, empty for scripts
+	TypeArguments              []string                     // TypeArguments are the type arguments to the function or script
+	Arguments                  []TransactionSummaryArgument // Arguments are the decoded arguments, not including any signers
+
+	isScript bool // isScript is true if a script is executed, including a script in an orderless payload
+}
+
+// TransactionSummaryArgument is a single decoded argument in a [TransactionSummary]
+type TransactionSummaryArgument struct {
+	Type  string // Type is the Move type of the argument e.g. u64
+	Value any    // Value is the decoded value, or the raw BCS []byte if the type can't be decoded
+}
+
+// String outputs a multi-line human-readable description of the transaction
+func (summary *TransactionSummary) String() string {
+	out := strings.Builder{}
+	_, _ = fmt.Fprintf(&out, "Sender: %s\n", summary.Sender.String())
+	if summary.ReplayProtectionNonce != nil {
+		_, _ = fmt.Fprintf(&out, "Replay protection nonce: %d\n", *summary.ReplayProtectionNonce)
+	} else {
+		_, _ = fmt.Fprintf(&out, "Sequence number: %d\n", summary.SequenceNumber)
+	}
+	_, _ = fmt.Fprintf(&out, "Max gas amount: %d\n", summary.MaxGasAmount)
+	_, _ = fmt.Fprintf(&out, "Gas unit price: %d\n", summary.GasUnitPrice)
+	_, _ = fmt.Fprintf(&out, "Expiration timestamp: %d\n", summary.ExpirationTimestampSeconds)
+	_, _ = fmt.Fprintf(&out, "Chain id: %d\n", summary.ChainId)
+	if summary.MultisigAddress != nil {
+		_, _ = fmt.Fprintf(&out, "Multisig address: %s\n", summary.MultisigAddress.String())
+	}
+	if summary.Function != "" {
+		_, _ = fmt.Fprintf(&out, "Function: %s\n", summary.Function)
+	} else if summary.PayloadType == TransactionPayloadVariantScript || summary.isScript {
+		out.WriteString("Function: script\n")
+	}
+	if len(summary.TypeArguments) > 0 {
+		_, _ = fmt.Fprintf(&out, "Type arguments: %s\n", strings.Join(summary.TypeArguments, ", "))
+	}
+	for i, arg := range summary.Arguments {
+		_, _ = fmt.Fprintf(&out, "Argument %d (%s): %s\n", i, arg.Type, formatSummaryValue(arg.Value))
+	}
+	return out.String()
+}
+
+// formatSummaryValue formats a decoded value, addresses and bytes are formatted as hex rather than as arrays
+func formatSummaryValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "none"
+	case AccountAddress:
+		return value.String()
+	case []byte:
+		return BytesToHex(value)
+	case []any:
+		values := make([]string, len(value))
+		for i, v := range value {
+			values[i] = formatSummaryValue(v)
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// InspectRawTransaction decodes a [RawTransaction] into a [TransactionSummary] for review before signing.
+//
+// Entry function arguments are decoded using the on-chain ABI of the module.  Arguments of struct types other than
+// String, Object, and Option are left as raw BCS bytes.  For a [RawTransactionWithData], inspect the inner RawTxn.
+func (rc *NodeClient) InspectRawTransaction(rawTxn *RawTransaction) (*TransactionSummary, error) {
+	summary := &TransactionSummary{
+		Sender:                     rawTxn.Sender,
+		SequenceNumber:             rawTxn.SequenceNumber,
+		MaxGasAmount:               rawTxn.MaxGasAmount,
+		GasUnitPrice:               rawTxn.GasUnitPrice,
+		ExpirationTimestampSeconds: rawTxn.ExpirationTimestampSeconds,
+		ChainId:                    rawTxn.ChainId,
+	}
+	if rawTxn.Payload.Payload == nil {
+		return nil, errors.New("transaction has no payload")
+	}
+	summary.PayloadType = rawTxn.Payload.Payload.PayloadType()
+
+	switch payload := rawTxn.Payload.Payload.(type) {
+	case *EntryFunction:
+		err := rc.summarizeEntryFunction(summary, payload)
+		if err != nil {
+			return nil, err
+		}
+	case *Multisig:
+		multisigAddress := payload.MultisigAddress
+		summary.MultisigAddress = &multisigAddress
+		// The payload is optional, if it's not there, the transaction was previously stored on-chain
+		if payload.Payload != nil {
+			entryFunction, ok := payload.Payload.Payload.(*EntryFunction)
+			if !ok {
+				return nil, fmt.Errorf("unsupported multisig payload type %T", payload.Payload.Payload)
+			}
+			err := rc.summarizeEntryFunction(summary, entryFunction)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case *Script:
+		summarizeScript(summary, payload)
+	case *TransactionInnerPayload:
+		summary.MultisigAddress = payload.ExtraConfig.MultisigAddress
+		summary.ReplayProtectionNonce = payload.ExtraConfig.ReplayProtectionNonce
+		switch executable := payload.Executable.Payload.(type) {
+		case *EntryFunction:
+			err := rc.summarizeEntryFunction(summary, executable)
+			if err != nil {
+				return nil, err
+			}
+		case *Script:
+			summarizeScript(summary, executable)
+		case nil:
+			// Nothing is executed directly, the multisig transaction was previously stored on-chain
+		default:
+			return nil, fmt.Errorf("unsupported executable type %T", executable)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported payload type %T", payload)
+	}
+	return summary, nil
+}
+
+// summarizeScript fills in the type arguments and arguments of the summary from the script
+func summarizeScript(summary *TransactionSummary, script *Script) {
+	summary.isScript = true
+	summary.TypeArguments = typeTagStrings(script.ArgTypes)
+	summary.Arguments = make([]TransactionSummaryArgument, len(script.Args))
+	for i, arg := range script.Args {
+		summary.Arguments[i] = TransactionSummaryArgument{
+			Type:  scriptArgumentTypeString(arg.Variant),
+			Value: arg.Value,
+		}
+	}
+}
+
+// summarizeEntryFunction fills in the function and decoded arguments of the summary using the on-chain ABI
+func (rc *NodeClient) summarizeEntryFunction(summary *TransactionSummary, entryFunction *EntryFunction) (err error) {
+	summary.Function = fmt.Sprintf("%s::%s::%s", entryFunction.Module.Address.String(), entryFunction.Module.Name, entryFunction.Function)
+	summary.TypeArguments = typeTagStrings(entryFunction.ArgTypes)
+
+	module, err := rc.AccountModule(entryFunction.Module.Address, entryFunction.Module.Name)
+	if err != nil {
+		return err
+	}
+	// A cached ABI may be from before the module was upgraded, so don't keep it if it doesn't match the transaction
+	defer func() {
+		if err != nil && rc.moduleCache != nil {
+			rc.moduleCache.invalidate(entryFunction.Module.Address, entryFunction.Module.Name)
+		}
+	}()
+	if module.Abi == nil {
+		return fmt.Errorf("module %s::%s has no ABI", entryFunction.Module.Address.String(), entryFunction.Module.Name)
+	}
+	var params []string
+	found := false
+	for _, function := range module.Abi.ExposedFunctions {
+		if function.Name == entryFunction.Function {
+			params = function.Params
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("function %s not found in module ABI", summary.Function)
+	}
+
+	// Signers are not passed as arguments, they are provided by the transaction
+	argTypes := make([]*TypeTag, 0, len(params))
+	for _, param := range params {
+		param = strings.TrimPrefix(strings.TrimPrefix(param, "&mut "), "&")
+		typeTag, err := parseTypeTagWithGenerics(param)
+		if err != nil {
+			return err
+		}
+		if typeTag.Value.GetType() == TypeTagSigner {
+			continue
+		}
+		argTypes = append(argTypes, typeTag)
+	}
+	if len(argTypes) != len(entryFunction.Args) {
+		return fmt.Errorf("function %s expects %d arguments, transaction has %d", summary.Function, len(argTypes), len(entryFunction.Args))
+	}
+
+	summary.Arguments = make([]TransactionSummaryArgument, len(argTypes))
+	for i, argType := range argTypes {
+		value, err := decodeMoveValue(entryFunction.Args[i], argType, entryFunction.ArgTypes)
+		if errors.Is(err, errUnsupportedMoveType) {
+			value = entryFunction.Args[i]
+		} else if err != nil {
+			return fmt.Errorf("failed to decode argument %d of %s: %w", i, summary.Function, err)
+		}
+		summary.Arguments[i] = TransactionSummaryArgument{
+			Type:  argType.String(),
+			Value: value,
+		}
+	}
+	return nil
+}
+
+// errUnsupportedMoveType is returned when a Move type can't be decoded without knowing its struct layout
+var errUnsupportedMoveType = errors.New("unsupported move type")
+
+// decodeMoveValue decodes a single BCS encoded value of the given type.  Any generic type parameters are substituted
+// with typeArgs.
+//
+// Types are decoded as:
+//   - bool, u8, u16, u32, u64 as their Go equivalents
+//   - u128, u256 as big.Int
+//   - address, 0x1::object::Object<T> as AccountAddress
+//   - vector<u8> as []byte, other vectors as []any
+//   - 0x1::string::String as string
+//   - 0x1::option::Option<T> as nil or the inner value
+func decodeMoveValue(bytes []byte, typeTag *TypeTag, typeArgs []TypeTag) (any, error) {
+	des := bcs.NewDeserializer(bytes)
+	value, err := deserializeMoveValue(des, typeTag, typeArgs)
+	if err != nil {
+		return nil, err
+	}
+	if des.Error() != nil {
+		return nil, des.Error()
+	}
+	if des.Remaining() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after decoding %s", des.Remaining(), typeTag.String())
+	}
+	return value, nil
+}
+
+func deserializeMoveValue(des *bcs.Deserializer, typeTag *TypeTag, typeArgs []TypeTag) (any, error) {
+	switch inner := typeTag.Value.(type) {
+	case *BoolTag:
+		return des.Bool(), nil
+	case *U8Tag:
+		return des.U8(), nil
+	case *U16Tag:
+		return des.U16(), nil
+	case *U32Tag:
+		return des.U32(), nil
+	case *U64Tag:
+		return des.U64(), nil
+	case *U128Tag:
+		return des.U128(), nil
+	case *U256Tag:
+		return des.U256(), nil
+	case *AddressTag:
+		address := AccountAddress{}
+		address.UnmarshalBCS(des)
+		return address, nil
+	case *genericTag:
+		if inner.Num >= uint64(len(typeArgs)) {
+			return nil, fmt.Errorf("missing type argument for %s", inner.String())
+		}
+		return deserializeMoveValue(des, &typeArgs[inner.Num], typeArgs)
+	case *VectorTag:
+		if _, ok := inner.TypeParam.Value.(*U8Tag); ok {
+			return des.ReadBytes(), nil
+		}
+		length := des.SequenceLength()
+		// Don't trust the length for preallocation, every element takes at least one byte
+		values := make([]any, 0, min(int(length), des.Remaining()))
+		for range length {
+			if des.Error() != nil {
+				return nil, des.Error()
+			}
+			value, err := deserializeMoveValue(des, &inner.TypeParam, typeArgs)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case *StructTag:
+		if inner.Address != AccountOne {
+			return nil, errUnsupportedMoveType
+		}
+		switch {
+		case inner.Module == "string" && inner.Name == "String":
+			return des.ReadString(), nil
+		case inner.Module == "object" && inner.Name == "Object":
+			address := AccountAddress{}
+			address.UnmarshalBCS(des)
+			return address, nil
+		case inner.Module == "option" && inner.Name == "Option" && len(inner.TypeParams) == 1:
+			// Options are encoded as a vector of length 0 or 1
+			switch length := des.Uleb128(); length {
+			case 0:
+				return nil, nil
+			case 1:
+				return deserializeMoveValue(des, &inner.TypeParams[0], typeArgs)
+			default:
+				return nil, fmt.Errorf("invalid option length %d", length)
+			}
+		default:
+			return nil, errUnsupportedMoveType
+		}
+	default:
+		return nil, errUnsupportedMoveType
+	}
+}
+
+// typeTagStrings converts a list of [TypeTag] to their string representations
+func typeTagStrings(typeTags []TypeTag) []string {
+	out := make([]string, len(typeTags))
+	for i, typeTag := range typeTags {
+		out[i] = typeTag.String()
+	}
+	return out
+}
+
+// scriptArgumentTypeString gives the Move type of a [ScriptArgumentVariant]
+func scriptArgumentTypeString(variant ScriptArgumentVariant) string {
+	switch variant {
+	case ScriptArgumentU8:
+		return "u8"
+	case ScriptArgumentU16:
+		return "u16"
+	case ScriptArgumentU32:
+		return "u32"
+	case ScriptArgumentU64:
+		return "u64"
+	case ScriptArgumentU128:
+		return "u128"
+	case ScriptArgumentU256:
+		return "u256"
+	case ScriptArgumentAddress:
+		return "address"
+	case ScriptArgumentU8Vector:
+		return "vector<u8>"
+	case ScriptArgumentBool:
+		return "bool"
+	default:
+		return "unknown"
+	}
+}
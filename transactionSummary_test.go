@@ -0,0 +1,112 @@
+package aptos
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeMoveValue(t *testing.T) {
+	u64Bytes, err := bcs.SerializeU64(1000)
+	assert.NoError(t, err)
+	value, err := decodeMoveValue(u64Bytes, &TypeTag{Value: &U64Tag{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), value)
+
+	// Trailing bytes are rejected
+	_, err = decodeMoveValue(append(u64Bytes, 0), &TypeTag{Value: &U64Tag{}}, nil)
+	assert.Error(t, err)
+
+	u128Bytes, err := bcs.SerializeU128(*big.NewInt(5))
+	assert.NoError(t, err)
+	value, err = decodeMoveValue(u128Bytes, &TypeTag{Value: &U128Tag{}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, *big.NewInt(5), value)
+
+	addressBytes, err := bcs.Serialize(&AccountOne)
+	assert.NoError(t, err)
+	objectType, err := ParseTypeTag("0x1::object::Object<0x1::fungible_asset::Metadata>")
+	assert.NoError(t, err)
+	value, err = decodeMoveValue(addressBytes, objectType, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, AccountOne, value)
+
+	// Generics are substituted with the type arguments
+	value, err = decodeMoveValue(addressBytes, &TypeTag{Value: &genericTag{Num: 0}}, []TypeTag{{Value: &AddressTag{}}})
+	assert.NoError(t, err)
+	assert.Equal(t, AccountOne, value)
+
+	stringBytes, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.WriteString("hello")
+	})
+	assert.NoError(t, err)
+	value, err = decodeMoveValue(stringBytes, &TypeTag{Value: NewStringTag()}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", value)
+
+	vectorType, err := ParseTypeTag("vector<u16>")
+	assert.NoError(t, err)
+	vectorBytes, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.Uleb128(2)
+		ser.U16(1)
+		ser.U16(2)
+	})
+	assert.NoError(t, err)
+	value, err = decodeMoveValue(vectorBytes, vectorType, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{uint16(1), uint16(2)}, value)
+
+	optionType, err := ParseTypeTag("0x1::option::Option<u8>")
+	assert.NoError(t, err)
+	value, err = decodeMoveValue([]byte{0}, optionType, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = decodeMoveValue([]byte{1, 7}, optionType, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(7), value)
+
+	// Unknown structs can't be decoded
+	structType, err := ParseTypeTag("0x3::my_mod::MyStruct")
+	assert.NoError(t, err)
+	_, err = decodeMoveValue([]byte{1}, structType, nil)
+	assert.ErrorIs(t, err, errUnsupportedMoveType)
+}
+
+func TestTransactionSummaryString(t *testing.T) {
+	summary := &TransactionSummary{
+		Sender:      AccountOne,
+		ChainId:     4,
+		PayloadType: TransactionPayloadVariantEntryFunction,
+		Function:    "0x1::aptos_account::transfer",
+		Arguments: []TransactionSummaryArgument{
+			{Type: "address", Value: AccountTwo},
+			{Type: "u64", Value: uint64(100)},
+		},
+	}
+	out := summary.String()
+	assert.Contains(t, out, "Function: 0x1::aptos_account::transfer\n")
+	assert.Contains(t, out, "Argument 0 (address): 0x2\n")
+	assert.Contains(t, out, "Argument 1 (u64): 100\n")
+}
+
+func TestInspectRawTransactionOrderlessScript(t *testing.T) {
+	// Scripts are summarized without fetching anything from the node
+	client, err := NewNodeClient("http://localhost:8080/v1", 4)
+	assert.NoError(t, err)
+	payload, err := OrderlessPayload(TransactionPayload{Payload: &Script{
+		Code:     []byte{0xa1, 0x1c, 0xeb, 0x0b},
+		ArgTypes: []TypeTag{},
+		Args:     []ScriptArgument{ScriptArgU64(5)},
+	}}, 9)
+	assert.NoError(t, err)
+	summary, err := client.InspectRawTransaction(&RawTransaction{Sender: AccountOne, SequenceNumber: OrderlessSequenceNumber, Payload: payload, ChainId: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9), *summary.ReplayProtectionNonce)
+	out := summary.String()
+	assert.Contains(t, out, "Replay protection nonce: 9\n")
+	assert.NotContains(t, out, "Sequence number")
+	assert.Contains(t, out, "Function: script\n")
+	assert.Contains(t, out, "Argument 0 (u64): 5\n")
+}
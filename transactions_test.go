@@ -1,6 +1,10 @@
 package aptos
 
 import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -51,6 +55,55 @@ func TestRawTransactionSign(t *testing.T) {
 	assert.Equal(t, txn, txn2)
 }
 
+func TestSignedTransactionCraftedLength(t *testing.T) {
+	sender, err := NewEd25519Account()
+	assert.NoError(t, err)
+	payload := &EntryFunction{
+		Module:   ModuleId{Address: AccountOne, Name: "m"},
+		Function: "f",
+		ArgTypes: []TypeTag{},
+		Args:     [][]byte{},
+	}
+	rawTxn := RawTransaction{
+		Sender:                     sender.Address,
+		SequenceNumber:             1,
+		Payload:                    TransactionPayload{Payload: payload},
+		MaxGasAmount:               1000,
+		GasUnitPrice:               100,
+		ExpirationTimestampSeconds: 1714158778,
+		ChainId:                    4,
+	}
+	signedTxn, err := rawTxn.SignedTransaction(sender)
+	assert.NoError(t, err)
+	serialized, err := bcs.Serialize(signedTxn)
+	assert.NoError(t, err)
+
+	// sender, sequence number, payload variant, module address, module name, function name, type args, then args
+	argsOffset := 32 + 8 + 1 + 32 + 2 + 2 + 1
+	assert.Equal(t, byte(0), serialized[argsOffset])
+	craft := func(prefix ...byte) []byte {
+		crafted := append([]byte{}, serialized[:argsOffset]...)
+		crafted = append(crafted, prefix...)
+		return append(crafted, serialized[argsOffset+1:]...)
+	}
+
+	// A length past the maximum is rejected before anything is allocated
+	var limitErr *bcs.LimitExceededError
+	err = bcs.Deserialize(&SignedTransaction{}, craft(0xff, 0xff, 0xff, 0xff, 0x0f))
+	assert.ErrorAs(t, err, &limitErr)
+
+	// A length within the maximum, but far past the input, runs out of bytes instead
+	err = bcs.Deserialize(&SignedTransaction{}, craft(0xff, 0xff, 0xff, 0xff, 0x07))
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &limitErr))
+
+	// The same applies to a lowered limit
+	des := bcs.NewDeserializer(craft(0x10))
+	des.MaxSequenceLength = 8
+	(&SignedTransaction{}).UnmarshalBCS(des)
+	assert.ErrorAs(t, des.Error(), &limitErr)
+}
+
 func TestTPMarshal(t *testing.T) {
 	var wat TransactionPayload
 	var ser bcs.Serializer
@@ -58,3 +111,202 @@ func TestTPMarshal(t *testing.T) {
 	// without a payload, it should fail
 	assert.Error(t, ser.Error())
 }
+
+func TestRawTransactionJSON(t *testing.T) {
+	transfer, err := CoinTransferPayload(nil, AccountTwo, 10_000)
+	assert.NoError(t, err)
+	generic, err := CoinTransferPayload(&TypeTag{Value: &StructTag{Address: AccountThree, Module: "coin", Name: "Coin"}}, AccountTwo, 1)
+	assert.NoError(t, err)
+
+	payloads := []TransactionPayloadImpl{
+		transfer,
+		generic,
+		&Multisig{MultisigAddress: AccountThree},
+		&Multisig{MultisigAddress: AccountThree, Payload: &MultisigTransactionPayload{
+			Variant: MultisigTransactionPayloadVariantEntryFunction,
+			Payload: transfer,
+		}},
+		&Script{
+			Code:     []byte{0xa1, 0x1c, 0xeb, 0x0b},
+			ArgTypes: []TypeTag{},
+			Args:     []ScriptArgument{{Variant: ScriptArgumentU64, Value: uint64(5)}},
+		},
+	}
+	// Orderless payloads of each of the above
+	for _, payload := range payloads {
+		orderless, err := OrderlessPayload(TransactionPayload{Payload: payload}, 7)
+		assert.NoError(t, err)
+		payloads = append(payloads, orderless.Payload)
+	}
+
+	for _, payload := range payloads {
+		txn := &RawTransaction{
+			Sender:                     AccountOne,
+			SequenceNumber:             5,
+			Payload:                    TransactionPayload{Payload: payload},
+			MaxGasAmount:               1000,
+			GasUnitPrice:               100,
+			ExpirationTimestampSeconds: 1714158778,
+			ChainId:                    4,
+		}
+		expected, err := bcs.Serialize(txn)
+		assert.NoError(t, err)
+
+		jsonBytes, err := json.Marshal(txn)
+		assert.NoError(t, err)
+
+		decoded := &RawTransaction{}
+		assert.NoError(t, json.Unmarshal(jsonBytes, decoded))
+		actual, err := bcs.Serialize(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual, string(jsonBytes))
+	}
+
+	jsonBytes, err := json.Marshal(&RawTransaction{Sender: AccountOne, Payload: TransactionPayload{Payload: transfer}})
+	assert.NoError(t, err)
+	assert.Contains(t, string(jsonBytes), `"function":"0x1::aptos_account::transfer"`)
+	assert.Contains(t, string(jsonBytes), `"sequence_number":"0"`)
+
+	// The nonce and multisig address of an orderless payload are in the JSON
+	orderless, err := OrderlessPayload(TransactionPayload{Payload: &Multisig{MultisigAddress: AccountThree}}, 12345)
+	assert.NoError(t, err)
+	jsonBytes, err = json.Marshal(&RawTransaction{Sender: AccountOne, Payload: orderless})
+	assert.NoError(t, err)
+	assert.Contains(t, string(jsonBytes), `"type":"inner_payload"`)
+	assert.Contains(t, string(jsonBytes), `"executable":"empty"`)
+	assert.Contains(t, string(jsonBytes), `"replay_protection_nonce":"12345"`)
+	assert.Contains(t, string(jsonBytes), `"multisig_address":"0x3"`)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"sequence_number":"0","max_gas_amount":"0","gas_unit_price":"0","expiration_timestamp_secs":"0","payload":{"type":"unknown"}}`), &RawTransaction{}))
+}
+
+func TestScriptArgumentConstructors(t *testing.T) {
+	vectorU16, err := ScriptArgVectorU16([]uint16{1, 2})
+	assert.NoError(t, err)
+	serialized, err := bcs.Serialize(&vectorU16)
+	assert.NoError(t, err)
+	// Serialized variant, then the BCS vector<u16> as bytes
+	assert.Equal(t, []byte{9, 5, 2, 1, 0, 2, 0}, serialized)
+
+	str, err := ScriptArgString("hi")
+	assert.NoError(t, err)
+	vectorU64, err := ScriptArgVectorU64([]uint64{1})
+	assert.NoError(t, err)
+	vectorU128, err := ScriptArgVectorU128([]big.Int{*big.NewInt(1)})
+	assert.NoError(t, err)
+	vectorAddress, err := ScriptArgVectorAddress([]AccountAddress{AccountOne})
+	assert.NoError(t, err)
+	vectorString, err := ScriptArgVectorString([]string{"a", "b"})
+	assert.NoError(t, err)
+	_, err = ScriptArgVectorU256([]big.Int{*big.NewInt(-1)})
+	assert.Error(t, err)
+
+	args := []ScriptArgument{
+		ScriptArgU8(1),
+		ScriptArgU16(2),
+		ScriptArgU32(3),
+		ScriptArgU64(4),
+		ScriptArgU128(*big.NewInt(5)),
+		ScriptArgU256(*big.NewInt(6)),
+		ScriptArgBool(true),
+		ScriptArgAddress(AccountTwo),
+		ScriptArgVectorU8([]byte{7}),
+		str,
+		vectorU16,
+		vectorU64,
+		vectorU128,
+		vectorAddress,
+		vectorString,
+	}
+	for _, arg := range args {
+		serialized, err := bcs.Serialize(&arg)
+		assert.NoError(t, err)
+		deserialized := ScriptArgument{}
+		assert.NoError(t, bcs.Deserialize(&deserialized, serialized))
+		assert.Equal(t, arg, deserialized)
+	}
+}
+
+func TestScriptArgumentConstructorsMatchLiterals(t *testing.T) {
+	// The scalar constructors build the same arguments as the struct literals
+	amount := uint64(1)
+	dest := AccountOne
+	script := &Script{
+		Code:     []byte{0xa1, 0x1c, 0xeb, 0x0b},
+		ArgTypes: []TypeTag{},
+		Args: []ScriptArgument{{
+			Variant: ScriptArgumentU64,
+			Value:   amount,
+		}, {
+			Variant: ScriptArgumentAddress,
+			Value:   dest,
+		}},
+	}
+	fromConstructors := &Script{
+		Code:     script.Code,
+		ArgTypes: []TypeTag{},
+		Args: []ScriptArgument{
+			ScriptArgU64(amount),
+			ScriptArgAddress(dest),
+		},
+	}
+	assert.Equal(t, script, fromConstructors)
+
+	expected, err := bcs.Serialize(script)
+	assert.NoError(t, err)
+	serialized, err := bcs.Serialize(fromConstructors)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, serialized)
+}
+
+func TestEntryFunctionStringEquals(t *testing.T) {
+	transfer, err := CoinTransferPayload(nil, AccountTwo, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1::aptos_account::transfer(0x0000000000000000000000000000000000000000000000000000000000000002, 0x6400000000000000)", transfer.String())
+
+	generic, err := CoinTransferPayload(&TypeTag{Value: &StructTag{Address: AccountThree, Module: "coin", Name: "Coin"}}, AccountTwo, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1::aptos_account::transfer_coins<0x3::coin::Coin>(0x0000000000000000000000000000000000000000000000000000000000000002, 0x6400000000000000)", generic.String())
+
+	same, err := CoinTransferPayload(nil, AccountTwo, 100)
+	assert.NoError(t, err)
+	assert.True(t, transfer.Equals(same))
+	assert.False(t, transfer.Equals(generic))
+	assert.False(t, transfer.Equals(nil))
+
+	otherAmount, err := CoinTransferPayload(nil, AccountTwo, 101)
+	assert.NoError(t, err)
+	assert.False(t, transfer.Equals(otherAmount))
+
+	otherCoin, err := CoinTransferPayload(&TypeTag{Value: &StructTag{Address: AccountThree, Module: "coin", Name: "OtherCoin"}}, AccountTwo, 100)
+	assert.NoError(t, err)
+	assert.False(t, generic.Equals(otherCoin))
+}
+
+func TestRegisterCoinPayload(t *testing.T) {
+	payload := RegisterCoinPayload(AptosCoinTypeTag)
+	assert.Equal(t, "0x1::managed_coin::register<0x1::aptos_coin::AptosCoin>()", payload.String())
+
+	serialized, err := bcs.Serialize(payload)
+	assert.NoError(t, err)
+	expected := append([]byte{}, AccountOne[:]...)
+	expected = append(expected, 12)
+	expected = append(expected, "managed_coin"...)
+	expected = append(expected, 8)
+	expected = append(expected, "register"...)
+	// One type argument, a struct tag for 0x1::aptos_coin::AptosCoin with no type params
+	expected = append(expected, 1, byte(TypeTagStruct))
+	expected = append(expected, AccountOne[:]...)
+	expected = append(expected, 10)
+	expected = append(expected, "aptos_coin"...)
+	expected = append(expected, 9)
+	expected = append(expected, "AptosCoin"...)
+	expected = append(expected, 0)
+	// No arguments
+	expected = append(expected, 0)
+	assert.Equal(t, expected, serialized)
+
+	deserialized := &EntryFunction{}
+	assert.NoError(t, bcs.Deserialize(deserialized, serialized))
+	assert.True(t, payload.Equals(deserialized))
+}
@@ -0,0 +1,91 @@
+package aptos
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// wrappingTransport is implemented by the SDK's [http.RoundTripper] middleware, such as [NewHedgedClient] and
+// [NewPerHostRateLimitedClient], so the base transport can be replaced or tuned without removing the middleware
+type wrappingTransport interface {
+	http.RoundTripper
+	innerTransport() http.RoundTripper
+	setInnerTransport(inner http.RoundTripper)
+}
+
+// baseTransport unwraps any SDK middleware, and returns the transport that actually sends requests
+func baseTransport(transport http.RoundTripper) http.RoundTripper {
+	for {
+		wrapper, ok := transport.(wrappingTransport)
+		if !ok {
+			break
+		}
+		transport = wrapper.innerTransport()
+	}
+	if transport == nil {
+		return http.DefaultTransport
+	}
+	return transport
+}
+
+// replaceBaseTransport replaces the transport that actually sends requests, keeping any SDK middleware around it
+func replaceBaseTransport(transport http.RoundTripper, base http.RoundTripper) http.RoundTripper {
+	wrapper, ok := transport.(wrappingTransport)
+	if !ok {
+		return base
+	}
+	wrapper.setInnerTransport(replaceBaseTransport(wrapper.innerTransport(), base))
+	return wrapper
+}
+
+// WithTransport sets the [http.Transport] used to send requests, e.g. to tune connection pooling under high
+// concurrency.  Returns the same client for chaining.
+//
+// Any SDK middleware on the [http.Client], such as [NewHedgedClient] or [NewPerHostRateLimitedClient], is kept, and
+// the transport is used underneath it.  Other custom transports are replaced.  As the [http.Client] is shared, this
+// also applies to the indexer client.  This must be called before making any requests.
+//
+//	client.WithTransport(&http.Transport{MaxIdleConnsPerHost: 100, ForceAttemptHTTP2: true})
+func (rc *NodeClient) WithTransport(transport *http.Transport) *NodeClient {
+	rc.client.Transport = replaceBaseTransport(rc.client.Transport, transport)
+	return rc
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections kept per host, which defaults to 2 and limits
+// connection reuse when sending many concurrent requests.  Returns the same client for chaining.
+//
+// See [NodeClient.WithTransport] for how this interacts with middleware.
+func (rc *NodeClient) WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) *NodeClient {
+	rc.tuneTransport(func(transport *http.Transport) {
+		transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+		if transport.MaxIdleConns != 0 && transport.MaxIdleConns < maxIdleConnsPerHost {
+			transport.MaxIdleConns = maxIdleConnsPerHost
+		}
+	})
+	return rc
+}
+
+// WithForceHTTP2 sets whether HTTP/2 is attempted, even with a custom dialer or TLS config.  Returns the same client
+// for chaining.
+//
+// See [NodeClient.WithTransport] for how this interacts with middleware.
+func (rc *NodeClient) WithForceHTTP2(forceHTTP2 bool) *NodeClient {
+	rc.tuneTransport(func(transport *http.Transport) {
+		transport.ForceAttemptHTTP2 = forceHTTP2
+	})
+	return rc
+}
+
+// tuneTransport updates a copy of the base [http.Transport], so shared transports like [http.DefaultTransport] are
+// never modified
+func (rc *NodeClient) tuneTransport(update func(transport *http.Transport)) {
+	base, ok := baseTransport(rc.client.Transport).(*http.Transport)
+	if !ok {
+		slog.Warn("cannot tune custom http transport, use WithTransport instead", "type", fmt.Sprintf("%T", baseTransport(rc.client.Transport)))
+		return
+	}
+	tuned := base.Clone()
+	update(tuned)
+	rc.client.Transport = replaceBaseTransport(rc.client.Transport, tuned)
+}
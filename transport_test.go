@@ -0,0 +1,50 @@
+package aptos
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportTuning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"chain_id":4,"epoch":"1","ledger_version":"10","oldest_ledger_version":"0","ledger_timestamp":"1000000","node_role":"full_node","oldest_block_height":"0","block_height":"5","git_hash":""}`))
+	}))
+	defer server.Close()
+
+	// Tuning a client without a transport must not modify the default transport
+	client, err := NewNodeClient(server.URL, 4)
+	assert.NoError(t, err)
+	defaultMaxIdleConnsPerHost := http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost
+	client.WithMaxIdleConnsPerHost(200).WithForceHTTP2(false)
+	assert.Equal(t, defaultMaxIdleConnsPerHost, http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost)
+	transport := client.client.Transport.(*http.Transport)
+	assert.Equal(t, 200, transport.MaxIdleConnsPerHost)
+	assert.GreaterOrEqual(t, transport.MaxIdleConns, 200)
+	assert.False(t, transport.ForceAttemptHTTP2)
+	_, err = client.Info()
+	assert.NoError(t, err)
+
+	// Middleware is kept, and the transport is set underneath it
+	rateLimitedClient, err := NewPerHostRateLimitedClient(&http.Client{}, 100, 10)
+	assert.NoError(t, err)
+	hedgedClient, err := NewHedgedClient(rateLimitedClient, time.Second, 2)
+	assert.NoError(t, err)
+	client, err = NewNodeClientWithHttpClient(server.URL, 4, hedgedClient)
+	assert.NoError(t, err)
+	custom := &http.Transport{}
+	client.WithTransport(custom).WithMaxIdleConnsPerHost(50)
+	hedged, ok := client.client.Transport.(*hedgedTransport)
+	assert.True(t, ok)
+	rateLimited, ok := hedged.inner.(*perHostRateLimitedTransport)
+	assert.True(t, ok)
+	tuned, ok := rateLimited.inner.(*http.Transport)
+	assert.True(t, ok)
+	assert.Equal(t, 50, tuned.MaxIdleConnsPerHost)
+	assert.Equal(t, 0, custom.MaxIdleConnsPerHost)
+	_, err = client.Info()
+	assert.NoError(t, err)
+}
@@ -1,8 +1,10 @@
 package aptos
 
 import (
+	"errors"
 	"fmt"
 	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"strconv"
 	"strings"
 )
 
@@ -23,6 +25,8 @@ const (
 	TypeTagU16     TypeTagVariant = 8  // Represents the u16 type in Move U16Tag
 	TypeTagU32     TypeTagVariant = 9  // Represents the u32 type in Move U32Tag
 	TypeTagU256    TypeTagVariant = 10 // Represents the u256 type in Move U256Tag
+
+	typeTagGeneric TypeTagVariant = 254 // Represents a generic type parameter in an ABI genericTag, it cannot be serialized
 )
 
 // TypeTagImpl is an interface describing all the different types of [TypeTag].  Unfortunately because of how serialization
@@ -47,6 +51,82 @@ func (tt *TypeTag) String() string {
 	return tt.Value.String()
 }
 
+// Canonical gives the TypeTag string value with all addresses in long form, e.g.
+// 0x0000000000000000000000000000000000000000000000000000000000000001::string::String
+//
+// Unlike [TypeTag.String], this has exactly one form for each type, so it is suitable for use as a map key
+func (tt *TypeTag) Canonical() string {
+	switch inner := tt.Value.(type) {
+	case *VectorTag:
+		return fmt.Sprintf("vector<%s>", inner.TypeParam.Canonical())
+	case *StructTag:
+		return inner.Canonical()
+	default:
+		return tt.Value.String()
+	}
+}
+
+// IsVector returns true if the TypeTag is a vector e.g. vector<u8>
+func (tt *TypeTag) IsVector() bool {
+	_, ok := tt.Value.(*VectorTag)
+	return ok
+}
+
+// VectorInner returns the inner type of a vector e.g. u8 for vector<u8>, and false if the TypeTag is not a vector
+func (tt *TypeTag) VectorInner() (TypeTag, bool) {
+	vectorTag, ok := tt.Value.(*VectorTag)
+	if !ok {
+		return TypeTag{}, false
+	}
+	return vectorTag.TypeParam, true
+}
+
+// IsStruct returns true if the TypeTag is a struct e.g. 0x1::string::String
+func (tt *TypeTag) IsStruct() bool {
+	_, ok := tt.Value.(*StructTag)
+	return ok
+}
+
+// StructTag returns the inner [StructTag], and false if the TypeTag is not a struct
+func (tt *TypeTag) StructTag() (*StructTag, bool) {
+	structTag, ok := tt.Value.(*StructTag)
+	return structTag, ok
+}
+
+// Equals returns true if both TypeTags represent the same type, including all type parameters
+func (tt *TypeTag) Equals(other TypeTag) bool {
+	if tt.Value == nil || other.Value == nil {
+		return tt.Value == nil && other.Value == nil
+	}
+	if tt.Value.GetType() != other.Value.GetType() {
+		return false
+	}
+	switch inner := tt.Value.(type) {
+	case *VectorTag:
+		otherInner := other.Value.(*VectorTag)
+		return inner.TypeParam.Equals(otherInner.TypeParam)
+	case *StructTag:
+		otherInner := other.Value.(*StructTag)
+		if inner.Address != otherInner.Address || inner.Module != otherInner.Module || inner.Name != otherInner.Name {
+			return false
+		}
+		if len(inner.TypeParams) != len(otherInner.TypeParams) {
+			return false
+		}
+		for i := range inner.TypeParams {
+			if !inner.TypeParams[i].Equals(otherInner.TypeParams[i]) {
+				return false
+			}
+		}
+		return true
+	case *genericTag:
+		return inner.Num == other.Value.(*genericTag).Num
+	default:
+		// All other types have no inner values
+		return true
+	}
+}
+
 //region TypeTag bcs.Struct
 
 // MarshalBCS serializes the TypeTag to bytes
@@ -360,6 +440,39 @@ func (xt *VectorTag) UnmarshalBCS(des *bcs.Deserializer) {
 //endregion
 //endregion
 
+//region genericTag
+
+// genericTag represents a generic type parameter e.g. T0 in a function ABI.  It is only used for parsing ABIs, and cannot
+// be sent on-chain.
+type genericTag struct {
+	Num uint64 // Num is the index of the type parameter e.g. 0 for T0
+}
+
+//region genericTag TypeTagImpl
+
+func (xt *genericTag) GetType() TypeTagVariant {
+	return typeTagGeneric
+}
+
+func (xt *genericTag) String() string {
+	return "T" + strconv.FormatUint(xt.Num, 10)
+}
+
+//endregion
+
+//region genericTag bcs.Struct
+
+func (xt *genericTag) MarshalBCS(ser *bcs.Serializer) {
+	ser.SetError(errors.New("generic type parameters cannot be serialized"))
+}
+
+func (xt *genericTag) UnmarshalBCS(des *bcs.Deserializer) {
+	des.SetError(errors.New("generic type parameters cannot be deserialized"))
+}
+
+//endregion
+//endregion
+
 //region StructTag
 
 // StructTag represents an on-chain struct of the form address::module::name<T1,T2,...> and each T is a [TypeTag]
@@ -398,6 +511,27 @@ func (xt *StructTag) String() string {
 	return out.String()
 }
 
+// Canonical outputs the same as [StructTag.String], but with all addresses in long form
+func (xt *StructTag) Canonical() string {
+	out := strings.Builder{}
+	out.WriteString(xt.Address.StringLong())
+	out.WriteString("::")
+	out.WriteString(xt.Module)
+	out.WriteString("::")
+	out.WriteString(xt.Name)
+	if len(xt.TypeParams) != 0 {
+		out.WriteRune('<')
+		for i, tp := range xt.TypeParams {
+			if i != 0 {
+				out.WriteRune(',')
+			}
+			out.WriteString(tp.Canonical())
+		}
+		out.WriteRune('>')
+	}
+	return out.String()
+}
+
 //endregion
 
 //region StructTag bcs.Struct
@@ -464,6 +598,33 @@ func NewObjectTag(inner TypeTagImpl) *StructTag {
 	}
 }
 
+// CoinStoreTypeTag creates a 0x1::coin::CoinStore<coinType> TypeTag, the resource holding an account's legacy coin
+// balance, for use with e.g. [NodeClient.AccountResource]
+func CoinStoreTypeTag(coinType TypeTag) TypeTag {
+	return NewTypeTag(&StructTag{
+		Address:    AccountOne,
+		Module:     "coin",
+		Name:       "CoinStore",
+		TypeParams: []TypeTag{coinType},
+	})
+}
+
+// AptosCoinStoreTypeTag creates the 0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin> TypeTag
+func AptosCoinStoreTypeTag() TypeTag {
+	return CoinStoreTypeTag(AptosCoin())
+}
+
+// FungibleStoreTypeTag creates the 0x1::fungible_asset::FungibleStore TypeTag, the resource holding a fungible asset
+// balance at a store object's address
+func FungibleStoreTypeTag() TypeTag {
+	return NewTypeTag(&StructTag{
+		Address:    AccountOne,
+		Module:     "fungible_asset",
+		Name:       "FungibleStore",
+		TypeParams: []TypeTag{},
+	})
+}
+
 // AptosCoinTypeTag is the TypeTag for 0x1::aptos_coin::AptosCoin
 var AptosCoinTypeTag = TypeTag{&StructTag{
 	Address: AccountOne,
@@ -471,4 +632,209 @@ var AptosCoinTypeTag = TypeTag{&StructTag{
 	Name:    "AptosCoin",
 }}
 
+// AptosCoin returns a new TypeTag for 0x1::aptos_coin::AptosCoin, which is safe to modify unlike [AptosCoinTypeTag]
+func AptosCoin() TypeTag {
+	return TypeTag{&StructTag{
+		Address: AccountOne,
+		Module:  "aptos_coin",
+		Name:    "AptosCoin",
+	}}
+}
+
+//endregion
+
+//region TypeTag parsing
+
+// ParseTypeTag parses a Move type string into a [TypeTag] e.g. u64, vector<u8>, or 0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>
+//
+// Generic type parameters such as T0 are rejected, as they can't be used as type arguments.
+//
+//	typeTag, err := ParseTypeTag("0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>")
+func ParseTypeTag(inputStr string) (*TypeTag, error) {
+	return parseTypeTagString(inputStr, false)
+}
+
+// parseTypeTagWithGenerics parses a Move type string from a function ABI, where generic type parameters such as T0 are
+// parsed as a placeholder that renders as T0, and can't be serialized.
+func parseTypeTagWithGenerics(inputStr string) (*TypeTag, error) {
+	return parseTypeTagString(inputStr, true)
+}
+
+func parseTypeTagString(inputStr string, allowGenerics bool) (*TypeTag, error) {
+	parser := &typeTagParser{input: inputStr, allowGenerics: allowGenerics}
+	typeTag, err := parser.parseTypeTag()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse type tag '%s': %w", inputStr, err)
+	}
+	parser.skipWhitespace()
+	if parser.pos != len(parser.input) {
+		return nil, fmt.Errorf("failed to parse type tag '%s': unexpected character at position %d", inputStr, parser.pos)
+	}
+	return &typeTag, nil
+}
+
+// MustParseTypeTag parses a Move type string into a [TypeTag], and panics on failure.  This is meant for constants.
+//
+//	aptosCoinType := MustParseTypeTag("0x1::aptos_coin::AptosCoin")
+func MustParseTypeTag(inputStr string) *TypeTag {
+	typeTag, err := ParseTypeTag(inputStr)
+	if err != nil {
+		panic(err)
+	}
+	return typeTag
+}
+
+// ParseTypeTags parses a list of Move type strings, such as the type arguments for an entry function.  The first
+// failure is returned with the index of the offending input.
+//
+//	typeArgs, err := ParseTypeTags("0x1::aptos_coin::AptosCoin", "u64")
+func ParseTypeTags(inputs ...string) ([]TypeTag, error) {
+	typeTags := make([]TypeTag, len(inputs))
+	for i, input := range inputs {
+		typeTag, err := ParseTypeTag(input)
+		if err != nil {
+			return nil, fmt.Errorf("type tag %d: %w", i, err)
+		}
+		typeTags[i] = *typeTag
+	}
+	return typeTags, nil
+}
+
+// MustParseTypeTags parses a list of Move type strings, and panics on failure.  This is meant for constants.
+func MustParseTypeTags(inputs ...string) []TypeTag {
+	typeTags, err := ParseTypeTags(inputs...)
+	if err != nil {
+		panic(err)
+	}
+	return typeTags
+}
+
+// typeTagParser is a simple recursive descent parser for Move type strings
+type typeTagParser struct {
+	input         string
+	pos           int
+	allowGenerics bool // allowGenerics parses generic type params e.g. T0, which are only found in ABIs
+}
+
+func (p *typeTagParser) skipWhitespace() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// peek returns the next non-whitespace character, or 0 if at the end of the input
+func (p *typeTagParser) peek() byte {
+	p.skipWhitespace()
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// readToken reads up to the next delimiter, a token may be a primitive, a generic, or a struct without type params
+func (p *typeTagParser) readToken() string {
+	p.skipWhitespace()
+	start := p.pos
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case '<', '>', ',', ' ':
+			return p.input[start:p.pos]
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// parseTypeParams parses a list of type params in the form <T1, T2, ...>
+func (p *typeTagParser) parseTypeParams() ([]TypeTag, error) {
+	if p.peek() != '<' {
+		return nil, fmt.Errorf("expected '<' at position %d", p.pos)
+	}
+	p.pos++
+	params := make([]TypeTag, 0)
+	for {
+		param, err := p.parseTypeTag()
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '>':
+			p.pos++
+			return params, nil
+		default:
+			return nil, fmt.Errorf("expected ',' or '>' at position %d", p.pos)
+		}
+	}
+}
+
+func (p *typeTagParser) parseTypeTag() (TypeTag, error) {
+	token := p.readToken()
+	switch token {
+	case "":
+		return TypeTag{}, fmt.Errorf("expected type at position %d", p.pos)
+	case "bool":
+		return NewTypeTag(&BoolTag{}), nil
+	case "u8":
+		return NewTypeTag(&U8Tag{}), nil
+	case "u16":
+		return NewTypeTag(&U16Tag{}), nil
+	case "u32":
+		return NewTypeTag(&U32Tag{}), nil
+	case "u64":
+		return NewTypeTag(&U64Tag{}), nil
+	case "u128":
+		return NewTypeTag(&U128Tag{}), nil
+	case "u256":
+		return NewTypeTag(&U256Tag{}), nil
+	case "address":
+		return NewTypeTag(&AddressTag{}), nil
+	case "signer":
+		return NewTypeTag(&SignerTag{}), nil
+	case "vector":
+		params, err := p.parseTypeParams()
+		if err != nil {
+			return TypeTag{}, err
+		}
+		if len(params) != 1 {
+			return TypeTag{}, fmt.Errorf("vector must have exactly one type param, got %d", len(params))
+		}
+		return NewTypeTag(&VectorTag{TypeParam: params[0]}), nil
+	}
+
+	// Generic type params are in the form T0, T1, ...
+	if token[0] == 'T' {
+		if num, err := strconv.ParseUint(token[1:], 10, 64); err == nil {
+			if !p.allowGenerics {
+				return TypeTag{}, fmt.Errorf("generic type param '%s' is not a concrete type", token)
+			}
+			return NewTypeTag(&genericTag{Num: num}), nil
+		}
+	}
+
+	// Otherwise, it must be a struct address::module::name<T1, T2, ...>
+	parts := strings.Split(token, "::")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return TypeTag{}, fmt.Errorf("unknown type '%s'", token)
+	}
+	structTag := &StructTag{
+		Module:     parts[1],
+		Name:       parts[2],
+		TypeParams: []TypeTag{},
+	}
+	err := structTag.Address.ParseStringRelaxed(parts[0])
+	if err != nil {
+		return TypeTag{}, fmt.Errorf("invalid address in type '%s': %w", token, err)
+	}
+	if p.peek() == '<' {
+		structTag.TypeParams, err = p.parseTypeParams()
+		if err != nil {
+			return TypeTag{}, err
+		}
+	}
+	return NewTypeTag(structTag), nil
+}
+
 //endregion
@@ -88,3 +88,195 @@ func TestInvalidTypeTag(t *testing.T) {
 	err := bcs.Deserialize(tag, bytes)
 	assert.Error(t, err)
 }
+
+func TestParseTypeTag(t *testing.T) {
+	inputs := []string{
+		"bool",
+		"u8",
+		"u16",
+		"u32",
+		"u64",
+		"u128",
+		"u256",
+		"address",
+		"signer",
+		"vector<u8>",
+		"vector<vector<address>>",
+		"0x1::string::String",
+		"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>",
+		"0x1::option::Option<vector<0x1::object::Object<0x1::string::String>>>",
+		"0x3::my_mod::MultiType<u8,0x1::string::String>",
+	}
+	for _, input := range inputs {
+		tag, err := ParseTypeTag(input)
+		assert.NoError(t, err)
+		assert.Equal(t, input, tag.String())
+	}
+
+	// Whitespace and long addresses are accepted
+	tag, err := ParseTypeTag("0x0000000000000000000000000000000000000000000000000000000000000001::coin::CoinStore< 0x1::aptos_coin::AptosCoin >")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", tag.String())
+
+	// Generics are only parsed from ABIs, and can't be serialized
+	for _, input := range []string{"T0", "vector<T12>", "0x1::coin::CoinStore<T1>"} {
+		_, err = ParseTypeTag(input)
+		assert.ErrorContains(t, err, "generic", input)
+		_, err = ParseTypeTags("u8", input)
+		assert.ErrorContains(t, err, "generic", input)
+		tag, err = parseTypeTagWithGenerics(input)
+		assert.NoError(t, err)
+		assert.Equal(t, input, tag.String())
+	}
+	tag, err = parseTypeTagWithGenerics("T3")
+	assert.NoError(t, err)
+	assert.Equal(t, &genericTag{Num: 3}, tag.Value)
+	_, err = bcs.Serialize(tag)
+	assert.Error(t, err)
+
+	invalidInputs := []string{
+		"",
+		"u7",
+		"vector",
+		"vector<u8",
+		"vector<u8,u8>",
+		"0x1::coin",
+		"0x1::coin::CoinStore<",
+		"0x1::coin::CoinStore<>",
+		"0xZZ::coin::CoinStore",
+		"u8 u8",
+		"u8>",
+	}
+	for _, input := range invalidInputs {
+		_, err := ParseTypeTag(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestTypeTagHelpers(t *testing.T) {
+	vectorTag, err := ParseTypeTag("vector<0x1::string::String>")
+	assert.NoError(t, err)
+	assert.True(t, vectorTag.IsVector())
+	assert.False(t, vectorTag.IsStruct())
+	_, ok := vectorTag.StructTag()
+	assert.False(t, ok)
+
+	inner, ok := vectorTag.VectorInner()
+	assert.True(t, ok)
+	assert.True(t, inner.IsStruct())
+	assert.False(t, inner.IsVector())
+	structTag, ok := inner.StructTag()
+	assert.True(t, ok)
+	assert.Equal(t, "String", structTag.Name)
+	_, ok = inner.VectorInner()
+	assert.False(t, ok)
+
+	other, err := ParseTypeTag("vector<0x0000000000000000000000000000000000000000000000000000000000000001::string::String>")
+	assert.NoError(t, err)
+	assert.True(t, vectorTag.Equals(*other))
+
+	for _, input := range []string{"vector<u8>", "0x1::string::String", "vector<0x1::option::Option<0x1::string::String>>", "u8"} {
+		different, err := ParseTypeTag(input)
+		assert.NoError(t, err)
+		assert.False(t, vectorTag.Equals(*different), input)
+	}
+
+	generic := NewTypeTag(&genericTag{Num: 1})
+	assert.True(t, generic.Equals(NewTypeTag(&genericTag{Num: 1})))
+	assert.False(t, generic.Equals(NewTypeTag(&genericTag{Num: 0})))
+}
+
+func TestTypeTagCanonical(t *testing.T) {
+	long := "0x0000000000000000000000000000000000000000000000000000000000000001"
+	other := "0x00000000000000000000000000000000000000000000000000000000000000ab"
+	inputs := []string{
+		"vector<0x1::coin::CoinStore<0xab::my_coin::MyCoin>>",
+		"vector<0x01::coin::CoinStore<0x00ab::my_coin::MyCoin>>",
+		"vector<" + long + "::coin::CoinStore<" + other + "::my_coin::MyCoin>>",
+	}
+	for _, input := range inputs {
+		typeTag, err := ParseTypeTag(input)
+		assert.NoError(t, err)
+		// Special addresses are short, all others are long
+		assert.Equal(t, "vector<0x1::coin::CoinStore<"+other+"::my_coin::MyCoin>>", typeTag.String())
+		assert.Equal(t, "vector<"+long+"::coin::CoinStore<"+other+"::my_coin::MyCoin>>", typeTag.Canonical())
+
+		// Round trip through String
+		roundTrip, err := ParseTypeTag(typeTag.String())
+		assert.NoError(t, err)
+		assert.Equal(t, typeTag.Canonical(), roundTrip.Canonical())
+	}
+
+	typeTag, err := ParseTypeTag("u64")
+	assert.NoError(t, err)
+	assert.Equal(t, "u64", typeTag.Canonical())
+}
+
+func TestParseTypeTags(t *testing.T) {
+	typeTags, err := ParseTypeTags("0x1::aptos_coin::AptosCoin", "u64", "vector<u8>")
+	assert.NoError(t, err)
+	assert.Len(t, typeTags, 3)
+	assert.Equal(t, "0x1::aptos_coin::AptosCoin", typeTags[0].String())
+	assert.Equal(t, "u64", typeTags[1].String())
+	assert.Equal(t, "vector<u8>", typeTags[2].String())
+
+	typeTags, err = ParseTypeTags()
+	assert.NoError(t, err)
+	assert.Empty(t, typeTags)
+
+	_, err = ParseTypeTags("u64", "vector<u8", "u8")
+	assert.ErrorContains(t, err, "type tag 1")
+	assert.ErrorContains(t, err, "vector<u8")
+
+	assert.Equal(t, "u8", MustParseTypeTag("u8").String())
+	assert.Len(t, MustParseTypeTags("u8", "bool"), 2)
+	assert.Panics(t, func() {
+		MustParseTypeTags("u8", "notatype<")
+	})
+	assert.Panics(t, func() {
+		MustParseTypeTag("")
+	})
+}
+
+func TestCanonicalModulesAndAptosCoin(t *testing.T) {
+	assert.Equal(t, "0x1::aptos_account", (&ModuleId{Address: AccountOne, Name: "aptos_account"}).String())
+	aptosAccount := AptosAccountModule()
+	assert.Equal(t, "0x1::aptos_account", aptosAccount.String())
+	coin := CoinModule()
+	assert.Equal(t, "0x1::coin", coin.String())
+	fungibleAsset := FungibleAssetModule()
+	assert.Equal(t, "0x1::fungible_asset", fungibleAsset.String())
+
+	aptosCoin := AptosCoin()
+	assert.Equal(t, "0x1::aptos_coin::AptosCoin", aptosCoin.String())
+	assert.True(t, aptosCoin.Equals(AptosCoinTypeTag))
+
+	// A new tag is returned each time, so changing one doesn't affect the others
+	aptosCoin.Value.(*StructTag).Name = "Other"
+	assert.Equal(t, "AptosCoin", AptosCoin().Value.(*StructTag).Name)
+
+	// Equal tags, not just AptosCoinTypeTag itself, use the AptosCoin transfer
+	payload, err := CoinTransferPayload(&aptosCoin, AccountTwo, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer_coins", payload.Function)
+	aptosCoin = AptosCoin()
+	payload, err = CoinTransferPayload(&aptosCoin, AccountTwo, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer", payload.Function)
+	assert.Equal(t, AptosAccountModule(), payload.Module)
+}
+
+func TestStoreTypeTags(t *testing.T) {
+	aptosCoinStore := AptosCoinStoreTypeTag()
+	assert.Equal(t, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", aptosCoinStore.String())
+	parsed, err := ParseTypeTag("0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>")
+	assert.NoError(t, err)
+	assert.True(t, aptosCoinStore.Equals(*parsed))
+
+	otherCoin := NewTypeTag(&StructTag{Address: AccountThree, Module: "coin", Name: "Coin", TypeParams: []TypeTag{}})
+	coinStore := CoinStoreTypeTag(otherCoin)
+	assert.Equal(t, "0x1::coin::CoinStore<0x3::coin::Coin>", coinStore.String())
+
+	fungibleStore := FungibleStoreTypeTag()
+	assert.Equal(t, "0x1::fungible_asset::FungibleStore", fungibleStore.String())
+}
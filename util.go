@@ -1,8 +1,13 @@
 package aptos
 
 import (
-	"github.com/aptos-labs/aptos-go-sdk/internal/util"
+	"fmt"
+	"math"
 	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/aptos-labs/aptos-go-sdk/internal/util"
 )
 
 // -- Note these are copied from internal/util/util.go to prevent package loops, but still allow devs to use it
@@ -32,3 +37,74 @@ func StrToUint64(s string) (uint64, error) {
 func StrToBigInt(val string) (num *big.Int, err error) {
 	return util.StrToBigInt(val)
 }
+
+// aptDecimals is the number of decimal places of APT, one octa is 10^-8 APT
+const aptDecimals = 8
+
+// octasPerAPT is the number of octas in one APT
+const octasPerAPT = uint64(100_000_000)
+
+// OctasToAPT formats an amount of octas as an exact decimal amount of APT, without trailing zeros
+//
+//	OctasToAPT(150_000_000) == "1.5"
+//	OctasToAPT(1) == "0.00000001"
+func OctasToAPT(octas uint64) string {
+	whole := octas / octasPerAPT
+	fraction := octas % octasPerAPT
+	if fraction == 0 {
+		return strconv.FormatUint(whole, 10)
+	}
+	return strings.TrimRight(fmt.Sprintf("%d.%0*d", whole, aptDecimals, fraction), "0")
+}
+
+// APTToOctas parses a decimal amount of APT e.g. "1.5" into octas, without any floating point rounding
+//
+// Returns an error if the amount isn't a non-negative decimal, has more than 8 decimal places, or doesn't fit in a
+// uint64 of octas.
+func APTToOctas(apt string) (uint64, error) {
+	wholeStr, fractionStr, hasPoint := strings.Cut(apt, ".")
+	if wholeStr == "" && fractionStr == "" {
+		return 0, fmt.Errorf("invalid APT amount %q", apt)
+	}
+	if hasPoint && fractionStr == "" {
+		return 0, fmt.Errorf("invalid APT amount %q, no digits after the decimal point", apt)
+	}
+	if len(fractionStr) > aptDecimals {
+		return 0, fmt.Errorf("invalid APT amount %q, more than %d decimal places", apt, aptDecimals)
+	}
+
+	whole := uint64(0)
+	if wholeStr != "" {
+		if !isDecimalDigits(wholeStr) {
+			return 0, fmt.Errorf("invalid APT amount %q", apt)
+		}
+		var err error
+		whole, err = strconv.ParseUint(wholeStr, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid APT amount %q: %w", apt, err)
+		}
+	}
+	fraction := uint64(0)
+	if fractionStr != "" {
+		if !isDecimalDigits(fractionStr) {
+			return 0, fmt.Errorf("invalid APT amount %q", apt)
+		}
+		// Pad to 8 digits, so it is in octas, it can't overflow
+		fraction, _ = strconv.ParseUint(fractionStr+strings.Repeat("0", aptDecimals-len(fractionStr)), 10, 64)
+	}
+
+	if whole > (math.MaxUint64-fraction)/octasPerAPT {
+		return 0, fmt.Errorf("APT amount %q overflows u64 octas", apt)
+	}
+	return whole*octasPerAPT + fraction, nil
+}
+
+// isDecimalDigits checks that the string is only the digits 0-9, as strconv also accepts signs and underscores
+func isDecimalDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -2,6 +2,7 @@ package aptos
 
 import (
 	"github.com/stretchr/testify/assert"
+	"math"
 	"math/big"
 	"testing"
 )
@@ -73,3 +74,53 @@ func TestStrToBigIntError(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestOctasToAPT(t *testing.T) {
+	assert.Equal(t, "0", OctasToAPT(0))
+	assert.Equal(t, "0.00000001", OctasToAPT(1))
+	assert.Equal(t, "1", OctasToAPT(100_000_000))
+	assert.Equal(t, "1.5", OctasToAPT(150_000_000))
+	assert.Equal(t, "184467440737.09551615", OctasToAPT(math.MaxUint64))
+}
+
+func TestAPTToOctas(t *testing.T) {
+	valid := map[string]uint64{
+		"0":                     0,
+		"1":                     100_000_000,
+		"1.5":                   150_000_000,
+		"0.00000001":            1,
+		".25":                   25_000_000,
+		"007.10":                710_000_000,
+		"184467440737.09551615": math.MaxUint64,
+	}
+	for apt, octas := range valid {
+		actual, err := APTToOctas(apt)
+		assert.NoError(t, err, apt)
+		assert.Equal(t, octas, actual, apt)
+		// Round trips back to the same amount
+		roundTrip, err := APTToOctas(OctasToAPT(octas))
+		assert.NoError(t, err)
+		assert.Equal(t, octas, roundTrip)
+	}
+
+	invalid := []string{
+		"",
+		".",
+		"1.",
+		"-1",
+		"+1",
+		"1_000",
+		"1e8",
+		"0x10",
+		"1.000000001",
+		"1.2.3",
+		" 1",
+		"184467440737.09551616",
+		"184467440738",
+		"99999999999999999999",
+	}
+	for _, apt := range invalid {
+		_, err := APTToOctas(apt)
+		assert.Error(t, err, apt)
+	}
+}